@@ -0,0 +1,91 @@
+package whatlanggo
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// acceptLanguagePrior is the prior weight of a language of an Accept-Language header
+// with a q-value of 1, compared to a language that is not in the header.
+const acceptLanguagePrior = 10
+
+// ParseAcceptLanguage returns the q-values of the languages of an HTTP Accept-Language
+// header such as "pt-BR, pt;q=0.9, en;q=0.5". Tags are mapped to languages with
+// ParseLang. Wildcards, unsupported languages, malformed q-values and languages with a
+// q-value of 0 are skipped. When a language appears several times, its highest q-value
+// is kept.
+func ParseAcceptLanguage(header string) map[Lang]float64 {
+	langs := map[Lang]float64{}
+	for _, entry := range strings.Split(header, ",") {
+		params := strings.Split(entry, ";")
+		tag := strings.TrimSpace(params[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q, ok := 1.0, true
+		for _, param := range params[1:] {
+			i := strings.IndexByte(param, '=')
+			if i < 0 || !strings.EqualFold(strings.TrimSpace(param[:i]), "q") {
+				continue
+			}
+			value, err := strconv.ParseFloat(strings.TrimSpace(param[i+1:]), 64)
+			if err != nil || value < 0 || value > 1 {
+				ok = false
+				break
+			}
+			q = value
+		}
+		if !ok || q == 0 {
+			continue
+		}
+
+		lang, err := ParseLang(tag)
+		if err != nil || lang == Und {
+			continue
+		}
+		if q > langs[lang] {
+			langs[lang] = q
+		}
+	}
+	return langs
+}
+
+// DetectWithAcceptLanguage detects the language and script of text, favouring the
+// languages of an HTTP Accept-Language header. Each language of the header is added to
+// the priors of options with a weight that grows with its q-value: a language with a
+// q-value of 1 is considered 10 times as likely as a language that is not in the
+// header. The header only breaks close calls; it does not override clear evidence.
+func DetectWithAcceptLanguage(text, header string, options Options) Info {
+	return DetectWithOptions(text, options.withAcceptLanguage(header))
+}
+
+// DetectWithAcceptLanguageContext is DetectWithAcceptLanguage unless ctx is done first,
+// like DetectContext.
+func DetectWithAcceptLanguageContext(ctx context.Context, text, header string, options Options) (Info, error) {
+	return DetectContext(ctx, text, options.withAcceptLanguage(header))
+}
+
+// withAcceptLanguage returns a copy of options whose priors are multiplied by the
+// weights of the languages of an Accept-Language header.
+func (options Options) withAcceptLanguage(header string) Options {
+	langs := ParseAcceptLanguage(header)
+	if len(langs) == 0 {
+		return options
+	}
+
+	priors := make(map[Lang]float64, len(options.Priors)+len(langs))
+	for lang, prior := range options.Priors {
+		priors[lang] = prior
+	}
+	for lang, q := range langs {
+		prior := 1.0
+		if p, ok := priors[lang]; ok && p > 0 {
+			prior = p
+		}
+		priors[lang] = prior * (1 + (acceptLanguagePrior-1)*q)
+	}
+	options.Priors = priors
+	return options
+}
@@ -0,0 +1,67 @@
+package whatlanggo
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := map[string]map[Lang]float64{
+		"":                                   {},
+		"*":                                  {},
+		"pt-BR":                              {Por: 1},
+		"pt-BR, pt;q=0.9, en;q=0.5, *;q=0.1": {Por: 1, Eng: 0.5},
+		"en;q=0.3, EN-gb;Q=0.7":              {Eng: 0.7},
+		"fr;q=0, de ; q = 0.8":               {Deu: 0.8},
+		"es;q=2, it;q=abc, nl":               {Nld: 1},
+		"xx, zh-Hant-TW;q=0.4":               {Cmn: 0.4},
+	}
+
+	for header, want := range tests {
+		got := ParseAcceptLanguage(header)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%q: want %v got %v", header, want, got)
+		}
+	}
+}
+
+func TestDetectWithAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		text   string
+		header string
+		want   Lang
+	}{
+		//Detected as Spanish without a header.
+		{"Como estás tu hoje", "", Spa},
+		{"Como estás tu hoje", "pt-PT,pt;q=0.9,en;q=0.8", Por},
+		{"Como estás tu hoje", "en", Spa},
+		{"La casa es grande", "pt-BR", Spa},
+	}
+
+	for _, tt := range tests {
+		got := DetectWithAcceptLanguage(tt.text, tt.header, Options{}).Lang
+		if got != tt.want {
+			t.Fatalf("%q %q: want %v got %v", tt.text, tt.header, LangToString(tt.want), LangToString(got))
+		}
+		info, err := DetectWithAcceptLanguageContext(context.Background(), tt.text, tt.header, Options{})
+		if err != nil || info.Lang != tt.want {
+			t.Fatalf("%q %q: want %v got %v (%v)", tt.text, tt.header, LangToString(tt.want), LangToString(info.Lang), err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if info, err := DetectWithAcceptLanguageContext(ctx, "Como estás tu hoje", "pt", Options{}); err != context.Canceled || info.Lang != Und {
+		t.Fatalf("want %v got %v and %v", context.Canceled, LangToString(info.Lang), err)
+	}
+
+	//Priors of the options are kept.
+	options := Options{Priors: map[Lang]float64{Spa: 0.1}}
+	if got := DetectWithAcceptLanguage("Como estás tu hoje", "en", options).Lang; got != Por {
+		t.Fatalf("want %v got %v", LangToString(Por), LangToString(got))
+	}
+	if len(options.Priors) != 1 {
+		t.Fatalf("options were modified: %v", options.Priors)
+	}
+}
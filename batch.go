@@ -0,0 +1,136 @@
+package whatlanggo
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// DetectBatch detects the languages of texts with options on Options.Workers
+// goroutines, and returns their Info in the order of texts. When ctx is done before
+// every text is detected, it returns the error of ctx, and the texts left undetected
+// are Und with ReasonCanceled.
+func DetectBatch(ctx context.Context, texts []string, options Options) ([]Info, error) {
+	infos := make([]Info, len(texts))
+	errs := make([]error, len(texts))
+	for i := range infos {
+		infos[i] = Info{Lang: Und, Reason: ReasonCanceled}
+	}
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < options.workers(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				infos[i], errs[i] = DetectContext(ctx, texts[i], options)
+			}
+		}()
+	}
+
+	err := func() error {
+		defer close(indexes)
+		for i := range texts {
+			select {
+			case indexes <- i:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	}()
+	wg.Wait()
+	if err != nil {
+		return infos, err
+	}
+	// ctx may be done after every text was sent to a worker.
+	for _, err := range errs {
+		if err != nil {
+			return infos, err
+		}
+	}
+	return infos, nil
+}
+
+// DetectStream detects the languages of the texts received from texts with options on
+// Options.Workers goroutines, and sends their Info on the returned channel in the order
+// the texts were received. The channel is closed once texts is closed and every text is
+// detected, or once ctx is done.
+func DetectStream(ctx context.Context, texts <-chan string, options Options) <-chan Info {
+	workers := options.workers()
+	type job struct {
+		text   string
+		result chan Info
+	}
+	jobs := make(chan job)
+	// results holds the result channels of the texts being detected, in the order of
+	// the texts, so that their Info are sent in order.
+	results := make(chan chan Info, workers)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for job := range jobs {
+				// When ctx is done, the Info is not sent: results are no longer read.
+				if info, err := DetectContext(ctx, job.text, options); err == nil {
+					job.result <- info
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(results)
+		defer close(jobs)
+		for {
+			var text string
+			var ok bool
+			select {
+			case text, ok = <-texts:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+
+			result := make(chan Info, 1)
+			select {
+			case results <- result:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- job{text, result}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	infos := make(chan Info)
+	go func() {
+		defer close(infos)
+		for result := range results {
+			var info Info
+			select {
+			case info = <-result:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case infos <- info:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return infos
+}
+
+// workers returns the number of goroutines batch detection uses.
+func (options Options) workers() int {
+	if options.Workers > 0 {
+		return options.Workers
+	}
+	return runtime.GOMAXPROCS(0)
+}
@@ -0,0 +1,130 @@
+package whatlanggo
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func batchTexts(t *testing.T) []string {
+	examples := readExamples(t)
+
+	texts := []string{"", "123", "Mi ne scias"}
+	for _, example := range examples {
+		texts = append(texts, example)
+	}
+	return texts
+}
+
+func TestDetectBatch(t *testing.T) {
+	texts := batchTexts(t)
+	for _, workers := range []int{0, 1, 3, 64} {
+		options := Options{Workers: workers}
+		infos, err := DetectBatch(context.Background(), texts, options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, text := range texts {
+			if want := DetectWithOptions(text, options); infos[i] != want {
+				t.Fatalf("%d workers, %q: want %v got %v", workers, text, want, infos[i])
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	infos, err := NewDetector(Options{}).DetectBatch(ctx, texts)
+	if err != context.Canceled {
+		t.Fatalf("want %v got %v", context.Canceled, err)
+	}
+	for _, info := range infos {
+		if info.Lang != Und || info.Reason != ReasonCanceled {
+			t.Fatalf("want %v with %v got %v with %v", LangToString(Und), ReasonCanceled, LangToString(info.Lang), info.Reason)
+		}
+	}
+
+	//A context done partway through the batch, once every text is sent to the single
+	//worker, leaves the texts that were not detected undetermined.
+	texts = texts[len(texts)-3:]
+	checks := 0
+	for {
+		if _, err := DetectContext(&cancelAfter{Context: context.Background(), n: checks}, texts[0], Options{}); err == nil {
+			break
+		}
+		checks++
+	}
+	infos, err = DetectBatch(&cancelAfter{Context: context.Background(), n: checks}, texts, Options{Workers: 1})
+	if err != context.Canceled {
+		t.Fatalf("want %v got %v", context.Canceled, err)
+	}
+	if want := Detect(texts[0]); infos[0] != want {
+		t.Fatalf("want %v got %v", want, infos[0])
+	}
+	for _, info := range infos[1:] {
+		if info.Lang != Und || info.Reason != ReasonCanceled {
+			t.Fatalf("want %v with %v got %v with %v", LangToString(Und), ReasonCanceled, LangToString(info.Lang), info.Reason)
+		}
+	}
+}
+
+func TestDetectStream(t *testing.T) {
+	texts := batchTexts(t)
+	for _, workers := range []int{0, 1, 3} {
+		options := Options{Workers: workers}
+		in := make(chan string)
+		go func() {
+			for _, text := range texts {
+				in <- text
+			}
+			close(in)
+		}()
+
+		i := 0
+		for info := range DetectStream(context.Background(), in, options) {
+			if want := DetectWithOptions(texts[i], options); info != want {
+				t.Fatalf("%d workers, %q: want %v got %v", workers, texts[i], want, info)
+			}
+			i++
+		}
+		if i != len(texts) {
+			t.Fatalf("%d workers: want %d results got %d", workers, len(texts), i)
+		}
+	}
+
+	//The results are closed when the context is done, even if texts is not.
+	ctx, cancel := context.WithCancel(context.Background())
+	in := make(chan string)
+	infos := NewDetector(Options{}).DetectStream(ctx, in)
+	in <- "Mi ne scias"
+	cancel()
+	for range infos {
+	}
+}
+
+func TestConcurrentReads(t *testing.T) {
+	texts := batchTexts(t)
+	model := NewProfileNaiveBayesModel()
+	ensemble := newEnsemble(t, WeightedModel{Weight: 1}, WeightedModel{Model: model, Weight: 1})
+
+	//Run with -race to check that the package-level maps and the models are only read.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j, text := range texts {
+				info := DetectWithOptions(text, Options{Priors: map[Lang]float64{Fra: 2}, ShortTextTrigrams: i})
+				_ = Langs[info.Lang]
+				_ = Scripts[info.Script]
+				ParseLang(info.Lang.Iso6393())
+				Probabilities(text, Options{})
+				if j%8 == i {
+					Explain(text, Options{})
+					DetectWithOptions(text, Options{Model: model})
+					ensemble.Detect(text, Options{})
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+}
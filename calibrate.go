@@ -0,0 +1,170 @@
+package whatlanggo
+
+import (
+	"context"
+	"math"
+)
+
+//go:generate go run ./cmd/whatlanggo-calibrate -corpus testdata/examples.json -holdout 0.5 -o calibration_data.go
+
+// LangProbability is the probability that a text is written in a language.
+type LangProbability struct {
+	Lang        Lang    `json:"lang"`
+	Probability float64 `json:"probability"`
+}
+
+// Calibration maps the trigram distances between a text and the language profiles to
+// probabilities. It is a multiclass Platt scaling: the probability of a language is
+// proportional to exp(-Scale * d), where d is the distance of the language minus the
+// distance of the closest language, in units of the distance charged for a missing
+// trigram. Scale is fitted by FitCalibration on a labeled corpus.
+type Calibration struct {
+	Scale float64
+}
+
+// CalibrationSample is a text of a labeled corpus, used to fit a Calibration.
+type CalibrationSample struct {
+	Text string
+	Lang Lang
+}
+
+// Probabilities returns the calibrated probabilities of the allowed languages for text,
+// from the most to the least probable language. They sum to 1. A language written in a
+// script used by no other language gets a probability of 1. Returns nil when no
+// language can be detected. With another model than TrigramModel, the probabilities
+// are the normalized exponentials of the scores of the model, and are not calibrated.
+func Probabilities(text string, options Options) []LangProbability {
+	text, _, _ = options.sample(text)
+	script := DetectScript(text)
+	if script == nil {
+		return nil
+	}
+	if options.ScriptFallback && !hasAllowedLang(script, options) {
+		info, _ := detectFallback(context.Background(), text, options, script)
+		script = info.Script
+	}
+
+	profiles, ok := scriptProfileKeys[script]
+	if !ok {
+		if lang, ok := scriptLangs[script]; ok && options.isAllowed(lang) {
+			return []LangProbability{{lang, 1}}
+		}
+		return nil
+	}
+
+	if !options.usesTrigramModel() {
+		scores, _ := scoreLangs(newFeatures(text, script, options), options)
+		return softmax(scores)
+	}
+
+	buf := getTrigramBuffer()
+	defer putTrigramBuffer(buf)
+	trigrams := buf.rankTrigrams(text)
+	langDistances := rankText(text, trigrams, options, profiles, scriptDictionaries[script])
+	if len(langDistances) == 0 {
+		return nil
+	}
+	return options.calibration().probabilities(langDistances)
+}
+
+// probabilities converts langDistances, sorted as returned by rankLangs, to probabilities.
+func (calibration Calibration) probabilities(langDistances []langDistance) []LangProbability {
+	scale := calibration.Scale
+	probabilities := make([]LangProbability, len(langDistances))
+	sum := 0.0
+	for i, langDist := range langDistances {
+		p := math.Exp(-scale * float64(langDist.dist-langDistances[0].dist) / maxTrigramDistance)
+		probabilities[i] = LangProbability{langDist.lang, p}
+		sum += p
+	}
+	for i := range probabilities {
+		probabilities[i].Probability /= sum
+	}
+	return probabilities
+}
+
+// calibrationExample holds what FitCalibration needs to know about a sample: the
+// distance gaps of the candidate languages and the index of the right one.
+type calibrationExample struct {
+	gaps  []float64
+	right int
+}
+
+// logLoss returns the average negative log-likelihood of the right languages.
+func (calibration Calibration) logLoss(examples []calibrationExample) float64 {
+	loss := 0.0
+	for _, example := range examples {
+		sum := 0.0
+		for _, gap := range example.gaps {
+			sum += math.Exp(-calibration.Scale * gap)
+		}
+		loss += calibration.Scale*example.gaps[example.right] + math.Log(sum)
+	}
+	return loss / float64(len(examples))
+}
+
+// FitCalibration fits a Calibration on a labeled corpus by minimizing the log-loss of
+// the right languages. Samples written in scripts used by a single language, or whose
+// language cannot be detected in their script, are ignored. The cmd/whatlanggo-calibrate
+// tool uses it to generate DefaultCalibration.
+func FitCalibration(samples []CalibrationSample) Calibration {
+	examples := calibrationExamples(samples)
+	if len(examples) == 0 {
+		return DefaultCalibration
+	}
+
+	// Coarse grid search, then golden-section search of the scale.
+	best := Calibration{}
+	bestLoss := math.Inf(1)
+	for i := 0; i <= 40; i++ {
+		calibration := Calibration{Scale: math.Pow(10, -2+float64(i)/10)}
+		if loss := calibration.logLoss(examples); loss < bestLoss {
+			best, bestLoss = calibration, loss
+		}
+	}
+
+	low, high := best.Scale/math.Pow(10, 0.1), best.Scale*math.Pow(10, 0.1)
+	ratio := (math.Sqrt(5) - 1) / 2
+	for i := 0; i < 40; i++ {
+		a := high - ratio*(high-low)
+		b := low + ratio*(high-low)
+		lossA := Calibration{a}.logLoss(examples)
+		lossB := Calibration{b}.logLoss(examples)
+		if lossA < lossB {
+			high = b
+		} else {
+			low = a
+		}
+	}
+	best.Scale = (low + high) / 2
+	return best
+}
+
+// calibrationExamples returns the examples FitCalibration fits on, skipping the samples
+// written in scripts used by a single language or whose language cannot be detected in
+// their script.
+func calibrationExamples(samples []CalibrationSample) []calibrationExample {
+	buf := getTrigramBuffer()
+	defer putTrigramBuffer(buf)
+
+	var examples []calibrationExample
+	for _, sample := range samples {
+		script := DetectScript(sample.Text)
+		profiles := scriptProfileKeys[script]
+		if _, ok := profiles[sample.Lang]; !ok {
+			continue
+		}
+
+		trigrams := buf.rankTrigrams(sample.Text)
+		langDistances := rankText(sample.Text, trigrams, Options{}, profiles, scriptDictionaries[script])
+		example := calibrationExample{gaps: make([]float64, len(langDistances))}
+		for i, langDist := range langDistances {
+			example.gaps[i] = float64(langDist.dist-langDistances[0].dist) / maxTrigramDistance
+			if langDist.lang == sample.Lang {
+				example.right = i
+			}
+		}
+		examples = append(examples, example)
+	}
+	return examples
+}
@@ -0,0 +1,150 @@
+package whatlanggo
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestProbabilities(t *testing.T) {
+	text := "Where there is a will there is a way"
+	probabilities := Probabilities(text, Options{})
+	if len(probabilities) != len(latinLangs) || probabilities[0].Lang != Eng {
+		t.Fatalf("got %v", probabilities)
+	}
+
+	sum := 0.0
+	for i, p := range probabilities {
+		sum += p.Probability
+		if i > 0 && p.Probability > probabilities[i-1].Probability {
+			t.Fatalf("probabilities are not sorted: %v", probabilities)
+		}
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Fatalf("want sum 1 got %v", sum)
+	}
+
+	probabilities = Probabilities(text, Options{Whitelist: map[Lang]bool{Deu: true, Fra: true}})
+	if len(probabilities) != 2 {
+		t.Fatalf("got %v", probabilities)
+	}
+
+	probabilities = Probabilities("我爱你", Options{})
+	if len(probabilities) != 1 || probabilities[0] != (LangProbability{Cmn, 1}) {
+		t.Fatalf("got %v", probabilities)
+	}
+
+	if probabilities := Probabilities("", Options{}); probabilities != nil {
+		t.Fatalf("got %v", probabilities)
+	}
+	if probabilities := Probabilities("我爱你", Options{Blacklist: map[Lang]bool{Cmn: true}}); probabilities != nil {
+		t.Fatalf("got %v", probabilities)
+	}
+
+	//A sharper calibration gives a higher probability to the closest language.
+	sharp := Calibration{Scale: DefaultCalibration.Scale * 10}
+	got := Probabilities("Vouloir, c'est pouvoir", Options{Calibration: &sharp})
+	want := Probabilities("Vouloir, c'est pouvoir", Options{})
+	if got[0].Lang != want[0].Lang || got[0].Probability <= want[0].Probability {
+		t.Fatalf("want more than %v got %v", want[0], got[0])
+	}
+}
+
+// Probabilities must match how often the most probable language is right on the half of
+// testdata/examples.json DefaultCalibration was not fitted on.
+func TestProbabilitiesCalibration(t *testing.T) {
+	samples := readCalibrationSamples(t, heldOutWords)
+
+	// Reliability diagram: the most probable languages are grouped by probability, and
+	// the accuracy of every group must be close to its average probability.
+	const bins = 5
+	var predicted, right, counts [bins]float64
+	for _, sample := range samples {
+		probabilities := Probabilities(sample.Text, Options{})
+		if len(probabilities) == 0 {
+			continue
+		}
+		p := probabilities[0].Probability
+		bin := int(p * bins)
+		if bin == bins {
+			bin--
+		}
+		predicted[bin] += p
+		counts[bin]++
+		if probabilities[0].Lang == sample.Lang {
+			right[bin]++
+		}
+	}
+
+	var allPredicted, allRight, total float64
+	for bin := range counts {
+		if counts[bin] >= 100 && math.Abs(predicted[bin]-right[bin])/counts[bin] > 0.1 {
+			t.Errorf("%d texts with probabilities in [%v, %v): average probability %v but accuracy %v", int(counts[bin]), float64(bin)/bins, float64(bin+1)/bins, predicted[bin]/counts[bin], right[bin]/counts[bin])
+		}
+		allPredicted += predicted[bin]
+		allRight += right[bin]
+		total += counts[bin]
+	}
+	if math.Abs(allPredicted-allRight)/total > 0.05 {
+		t.Fatalf("average probability %v but accuracy %v", allPredicted/total, allRight/total)
+	}
+}
+
+func TestFitCalibration(t *testing.T) {
+	samples := readCalibrationSamples(t, fittedWords)
+	calibration := FitCalibration(samples)
+	if calibration.Scale <= 0 {
+		t.Fatalf("got %v", calibration)
+	}
+	//DefaultCalibration is fitted on the same samples.
+	if math.Abs(calibration.Scale-DefaultCalibration.Scale) > 1e-6 {
+		t.Fatalf("want %v got %v", DefaultCalibration, calibration)
+	}
+
+	//The fit minimizes the log-loss of the samples it is given.
+	examples := calibrationExamples(samples)
+	for _, other := range []Calibration{{calibration.Scale / 2}, {calibration.Scale * 2}} {
+		if got, want := other.logLoss(examples), calibration.logLoss(examples); got < want {
+			t.Fatalf("%v has a log-loss of %v, lower than %v of %v", other, got, want, calibration)
+		}
+	}
+
+	if got := FitCalibration(nil); got != DefaultCalibration {
+		t.Fatalf("want %v got %v", DefaultCalibration, got)
+	}
+}
+
+// The halves of the words of every text of testdata/examples.json, split as
+// cmd/whatlanggo-calibrate -holdout 0.5 does.
+const (
+	fittedWords = iota
+	heldOutWords
+)
+
+// readCalibrationSamples returns the windows cmd/whatlanggo-calibrate cuts from the given
+// half of every text of testdata/examples.json: the whole half, and consecutive windows
+// of 1, 2, 3, 5, 8, 13 and 21 words shorter than it.
+func readCalibrationSamples(t *testing.T, half int) []CalibrationSample {
+	examples := readExamples(t)
+
+	var samples []CalibrationSample
+	for code, text := range examples {
+		words := strings.Fields(text)
+		split := len(words) - len(words)/2
+		if half == fittedWords {
+			words = words[:split]
+		} else {
+			words = words[split:]
+		}
+		if len(words) == 0 {
+			continue
+		}
+		samples = append(samples, CalibrationSample{strings.Join(words, " "), CodeToLang(code)})
+		for _, size := range []int{1, 2, 3, 5, 8, 13, 21} {
+			for i := 0; i+size <= len(words) && size < len(words); i += size {
+				samples = append(samples, CalibrationSample{strings.Join(words[i:i+size], " "), CodeToLang(code)})
+			}
+		}
+	}
+	return samples
+}
@@ -0,0 +1,13 @@
+// Code generated by cmd/whatlanggo-calibrate from testdata/examples.json; DO NOT EDIT.
+
+package whatlanggo
+
+// DefaultCalibration is the calibration used by Probabilities, fitted on 6235 samples of
+// 83 texts in 83 languages, taken from the first 50% of the words of every text.
+// testdata/examples.json holds a single text per language, a small fixture rather than a
+// representative corpus, so the calibration is only a rough one.
+// On the 6076 samples of the words left out, the log-loss is 1.2032 and the expected
+// calibration error over 10 bins is 0.0673.
+var DefaultCalibration = Calibration{
+	Scale: 1.2920097228838436,
+}
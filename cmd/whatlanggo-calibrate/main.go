@@ -0,0 +1,214 @@
+// Command whatlanggo-calibrate fits the calibration used by whatlanggo.Probabilities
+// on a labeled corpus, and writes it as Go source.
+//
+// The corpus is a JSON object mapping ISO 639-3 codes to a text or to a list of texts,
+// such as testdata/examples.json. Every text is split into windows of 1, 2, 3, 5, 8,
+// 13 and 21 words as well as taken as a whole, so that the calibration covers short
+// and long texts. With -holdout, the given share of the words at the end of every text
+// is left out of the fit, and the log-loss and the expected calibration error of the
+// calibration on them are reported.
+//
+// Usage:
+//
+//	whatlanggo-calibrate -corpus testdata/examples.json -holdout 0.5 -o calibration_data.go
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/abadojack/whatlanggo"
+)
+
+var windowSizes = []int{1, 2, 3, 5, 8, 13, 21}
+
+// eceBins is the number of bins of the expected calibration error.
+const eceBins = 10
+
+func main() {
+	corpus := flag.String("corpus", "testdata/examples.json", "labeled corpus")
+	output := flag.String("o", "", "output Go file, the calibration is printed when empty")
+	holdout := flag.Float64("holdout", 0, "share of the words at the end of every text left out of the fit")
+	flag.Parse()
+	if *holdout < 0 || *holdout >= 1 {
+		log.Fatalf("-holdout must be in [0, 1), got %v", *holdout)
+	}
+
+	texts, err := readCorpus(*corpus)
+	if err != nil {
+		log.Fatal(err)
+	}
+	samples, heldOut := split(texts, *holdout)
+
+	calibration := whatlanggo.FitCalibration(samples)
+	var logLoss, ece float64
+	var evaluated int
+	if len(heldOut) != 0 {
+		logLoss, ece, evaluated = evaluate(calibration, heldOut)
+		log.Printf("held-out log-loss %.4f, expected calibration error %.4f on %d samples", logLoss, ece, evaluated)
+	}
+	if *output == "" {
+		fmt.Printf("Scale: %v\nSamples: %d\n", calibration.Scale, len(samples))
+		return
+	}
+
+	langs := map[whatlanggo.Lang]bool{}
+	for _, text := range texts {
+		langs[text.lang] = true
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by cmd/whatlanggo-calibrate from %s; DO NOT EDIT.\n\n", *corpus)
+	buf.WriteString("package whatlanggo\n\n")
+	fmt.Fprintf(&buf, "// DefaultCalibration is the calibration used by Probabilities, fitted on %d samples of\n", len(samples))
+	fmt.Fprintf(&buf, "// %d texts in %d languages", len(texts), len(langs))
+	if *holdout > 0 {
+		fmt.Fprintf(&buf, ", taken from the first %v%% of the words of every text", (1-*holdout)*100)
+	}
+	buf.WriteString(".")
+	if len(texts) == len(langs) {
+		fmt.Fprintf(&buf, "\n// %s holds a single text per language, a small fixture rather than a\n", *corpus)
+		buf.WriteString("// representative corpus, so the calibration is only a rough one.")
+	}
+	if evaluated != 0 {
+		fmt.Fprintf(&buf, "\n// On the %d samples of the words left out, the log-loss is %.4f and the expected\n", evaluated, logLoss)
+		fmt.Fprintf(&buf, "// calibration error over %d bins is %.4f.", eceBins, ece)
+	}
+	fmt.Fprintf(&buf, "\nvar DefaultCalibration = Calibration{\nScale: %v,\n}\n", calibration.Scale)
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// labeledText is a text of the corpus and its language.
+type labeledText struct {
+	text string
+	lang whatlanggo.Lang
+}
+
+// readCorpus reads the texts of the corpus file.
+func readCorpus(path string) ([]labeledText, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var corpus map[string]json.RawMessage
+	if err := json.Unmarshal(data, &corpus); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, len(corpus))
+	for code := range corpus {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	var texts []labeledText
+	for _, code := range codes {
+		raw := corpus[code]
+		lang := whatlanggo.CodeToLang(code)
+		if lang == whatlanggo.Und {
+			return nil, fmt.Errorf("%s: unknown ISO 639-3 code %q", path, code)
+		}
+
+		var langTexts []string
+		if err := json.Unmarshal(raw, &langTexts); err != nil {
+			var text string
+			if err := json.Unmarshal(raw, &text); err != nil {
+				return nil, fmt.Errorf("%s: %s: want a text or a list of texts", path, code)
+			}
+			langTexts = []string{text}
+		}
+		for _, text := range langTexts {
+			texts = append(texts, labeledText{text, lang})
+		}
+	}
+	return texts, nil
+}
+
+// split splits the texts into the samples the calibration is fitted on, and the samples
+// of the holdout share of the words at the end of every text.
+func split(texts []labeledText, holdout float64) ([]whatlanggo.CalibrationSample, []whatlanggo.CalibrationSample) {
+	var samples, heldOut []whatlanggo.CalibrationSample
+	for _, text := range texts {
+		words := strings.Fields(text.text)
+		n := len(words) - int(float64(len(words))*holdout)
+		for _, window := range windows(strings.Join(words[:n], " ")) {
+			samples = append(samples, whatlanggo.CalibrationSample{Text: window, Lang: text.lang})
+		}
+		if n < len(words) {
+			for _, window := range windows(strings.Join(words[n:], " ")) {
+				heldOut = append(heldOut, whatlanggo.CalibrationSample{Text: window, Lang: text.lang})
+			}
+		}
+	}
+	return samples, heldOut
+}
+
+// evaluate returns the log-loss of the right languages and the expected calibration
+// error of the most probable languages according to calibration, and the number of
+// samples they are computed on: the samples whose language has a probability.
+func evaluate(calibration whatlanggo.Calibration, samples []whatlanggo.CalibrationSample) (float64, float64, int) {
+	var predicted, right, counts [eceBins]float64
+	logLoss, n := 0.0, 0
+	for _, sample := range samples {
+		probabilities := whatlanggo.Probabilities(sample.Text, whatlanggo.Options{Calibration: &calibration})
+		p := 0.0
+		for _, probability := range probabilities {
+			if probability.Lang == sample.Lang {
+				p = probability.Probability
+			}
+		}
+		if p == 0 {
+			continue
+		}
+		logLoss -= math.Log(p)
+		n++
+
+		top := probabilities[0].Probability
+		bin := int(top * eceBins)
+		if bin == eceBins {
+			bin--
+		}
+		predicted[bin] += top
+		counts[bin]++
+		if probabilities[0].Lang == sample.Lang {
+			right[bin]++
+		}
+	}
+	if n == 0 {
+		return 0, 0, 0
+	}
+
+	ece := 0.0
+	for bin := range counts {
+		ece += math.Abs(predicted[bin] - right[bin])
+	}
+	return logLoss / float64(n), ece / float64(n), n
+}
+
+// windows splits text into consecutive windows of every window size, plus the whole text.
+func windows(text string) []string {
+	words := strings.Fields(text)
+	windows := []string{text}
+	for _, size := range windowSizes {
+		for i := 0; i+size <= len(words) && size < len(words); i += size {
+			windows = append(windows, strings.Join(words[i:i+size], " "))
+		}
+	}
+	return windows
+}
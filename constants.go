@@ -1,7 +1,17 @@
 package whatlanggo
 
-const maxTrigramDistance = 300
-const maxTotalDistance = 90000
+const (
+	// defaultNgramOrder is the length of the n-grams of the built-in profiles.
+	defaultNgramOrder = 3
+	// defaultProfileDepth is the number of n-grams of the built-in profiles.
+	defaultProfileDepth = 300
+)
+
+// maxTrigramDistance is the distance a trigram of a built-in profile adds when it is
+// missing from the text: no trigram of the profile is further from its rank. A text
+// that shares no trigram with a profile is at maxTotalDistance from it.
+const maxTrigramDistance = defaultProfileDepth
+const maxTotalDistance = maxTrigramDistance * defaultProfileDepth
 
 // ReliableConfidenceThreshold is confidence rating that has to be succeeded
 // for the language detection to be considered reliable.
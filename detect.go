@@ -1,6 +1,8 @@
 package whatlanggo
 
 import (
+	"context"
+	"math"
 	"sort"
 	"unicode"
 )
@@ -21,75 +23,232 @@ func DetectLangWithOptions(text string, options Options) Lang {
 }
 
 // DetectWithOptions detects the language and script of the given text with the provided options.
+// When several languages are equally close to the text, the one with the lowest
+// ISO 639-3 code is returned.
 func DetectWithOptions(text string, options Options) Info {
+	info, _ := DetectContext(context.Background(), text, options)
+	return info
+}
+
+// DetectContext detects the language and script of the given text with the provided
+// options like DetectWithOptions, unless ctx is done first. Cancellation is checked
+// between script detection, trigram extraction and the scoring of every language
+// profile, so that detection of long texts stops soon after a deadline. When ctx is
+// done, DetectContext returns Und with ReasonCanceled and the error of ctx.
+func DetectContext(ctx context.Context, text string, options Options) (Info, error) {
+	text, analyzedRunes, sampled := options.sample(text)
+	info, err := detect(ctx, text, options)
+	if err != nil {
+		return Info{Lang: Und, Reason: ReasonCanceled}, err
+	}
+	info.AnalyzedRunes, info.Sampled = analyzedRunes, sampled
+	if options.RejectUnreliable && info.Lang != Und && !options.IsReliable(info) {
+		return Info{
+			Lang:          Und,
+			Script:        info.Script,
+			Confidence:    0,
+			Reason:        ReasonBelowThreshold,
+			AnalyzedRunes: info.AnalyzedRunes,
+			Sampled:       info.Sampled,
+		}, nil
+	}
+	return info, nil
+}
+
+// DetectLangContext detects only the language of the given text like DetectContext.
+func DetectLangContext(ctx context.Context, text string, options Options) (Lang, error) {
+	info, err := DetectContext(ctx, text, options)
+	return info.Lang, err
+}
+
+// detect detects the language and script of text, without applying the reliability
+// threshold. It returns the error of ctx when ctx is done before detection ends.
+func detect(ctx context.Context, text string, options Options) (Info, error) {
 	script := DetectScript(text)
+	if err := ctx.Err(); err != nil {
+		return Info{}, err
+	}
 	if script != nil {
-		lang, confidence := detectLangBaseOnScript(text, options, script)
-		return Info{
-			Lang:       lang,
-			Script:     script,
-			Confidence: confidence,
+		var info Info
+		if options.ScriptFallback && !hasAllowedLang(script, options) {
+			var err error
+			info, err = detectFallback(ctx, text, options, script)
+			if err != nil {
+				return Info{}, err
+			}
+			if info.Lang == Und {
+				info.Reason = ReasonFiltered
+			}
+		} else {
+			lang, confidence, err := detectLangBaseOnScript(ctx, text, options, script)
+			if err != nil {
+				return Info{}, err
+			}
+			info = Info{
+				Lang:       lang,
+				Script:     script,
+				Confidence: confidence,
+				Reason:     undeterminedReason(lang, script, options),
+			}
 		}
+		if info.Lang == Cmn && info.Script == unicode.Han {
+			info.HanVariant = detectHanVariant(text)
+		}
+		return info, nil
+	}
+
+	reason := ReasonNoLetters
+	if text == "" {
+		reason = ReasonEmptyText
+	} else if containsLetter(text) {
+		reason = ReasonUnsupportedScript
 	}
 
 	return Info{
-		Lang:       -1,
+		Lang:       Und,
 		Script:     nil,
 		Confidence: 0,
-	}
+		Reason:     reason,
+	}, nil
 }
 
-func detectLangBaseOnScript(text string, options Options, script *unicode.RangeTable) (Lang, float64) {
-	switch script {
-	case unicode.Latin:
-		return detectLangInProfiles(text, options, latinLangs)
-	case unicode.Cyrillic:
-		return detectLangInProfiles(text, options, cyrillicLangs)
-	case unicode.Devanagari:
-		return detectLangInProfiles(text, options, devanagariLangs)
-	case unicode.Hebrew:
-		return detectLangInProfiles(text, options, hebrewLangs)
-	case unicode.Ethiopic:
-		return detectLangInProfiles(text, options, ethiopicLangs)
-	case unicode.Arabic:
-		return detectLangInProfiles(text, options, arabicLangs)
-	case unicode.Han:
-		return Cmn, 1
-	case unicode.Bengali:
-		return Ben, 1
-	case unicode.Hangul:
-		return Kor, 1
-	case unicode.Georgian:
-		return Kat, 1
-	case unicode.Greek:
-		return Ell, 1
-	case unicode.Kannada:
-		return Kan, 1
-	case unicode.Tamil:
-		return Tam, 1
-	case unicode.Thai:
-		return Tha, 1
-	case unicode.Gujarati:
-		return Guj, 1
-	case unicode.Gurmukhi:
-		return Pan, 1
-	case unicode.Telugu:
-		return Tel, 1
-	case unicode.Malayalam:
-		return Mal, 1
-	case unicode.Oriya:
-		return Ori, 1
-	case unicode.Myanmar:
-		return Mya, 1
-	case unicode.Sinhala:
-		return Sin, 1
-	case unicode.Khmer:
-		return Khm, 1
-	case _HiraganaKatakana:
-		return Jpn, 1
+// undeterminedReason explains why detecting lang in a text written in script failed.
+func undeterminedReason(lang Lang, script *unicode.RangeTable, options Options) Reason {
+	switch {
+	case lang != Und:
+		return ReasonNone
+	case scriptProfiles[script] == nil && !isSingleLangScript(script):
+		return ReasonUnsupportedScript
+	case !hasAllowedLang(script, options):
+		return ReasonFiltered
 	default:
-		return -1, 0
+		return ReasonBelowThreshold
+	}
+}
+
+// scriptProfiles maps scripts shared by several languages to the profiles of these languages.
+var scriptProfiles = map[*unicode.RangeTable]langProfileList{
+	unicode.Latin:      latinLangs,
+	unicode.Cyrillic:   cyrillicLangs,
+	unicode.Devanagari: devanagariLangs,
+	unicode.Hebrew:     hebrewLangs,
+	unicode.Ethiopic:   ethiopicLangs,
+	unicode.Arabic:     arabicLangs,
+}
+
+// scriptLangs maps scripts used by a single language to that language.
+var scriptLangs = map[*unicode.RangeTable]Lang{
+	unicode.Han:       Cmn,
+	unicode.Bengali:   Ben,
+	unicode.Hangul:    Kor,
+	unicode.Georgian:  Kat,
+	unicode.Greek:     Ell,
+	unicode.Kannada:   Kan,
+	unicode.Tamil:     Tam,
+	unicode.Thai:      Tha,
+	unicode.Gujarati:  Guj,
+	unicode.Gurmukhi:  Pan,
+	unicode.Telugu:    Tel,
+	unicode.Malayalam: Mal,
+	unicode.Oriya:     Ori,
+	unicode.Myanmar:   Mya,
+	unicode.Sinhala:   Sin,
+	unicode.Khmer:     Khm,
+	_HiraganaKatakana: Jpn,
+}
+
+func detectLangBaseOnScript(ctx context.Context, text string, options Options, script *unicode.RangeTable) (Lang, float64, error) {
+	if _, ok := scriptProfiles[script]; ok {
+		if !options.usesTrigramModel() {
+			return detectLangWithModel(ctx, text, options, script)
+		}
+		return detectLangInProfiles(ctx, text, options, scriptProfileKeys[script], scriptDictionaries[script])
+	}
+	if lang, ok := scriptLangs[script]; ok {
+		lang, confidence := detectSingleLang(lang, options)
+		return lang, confidence, nil
+	}
+	return Und, 0, nil
+}
+
+// isSingleLangScript reports whether script is used by a single language.
+func isSingleLangScript(script *unicode.RangeTable) bool {
+	_, ok := scriptLangs[script]
+	return ok
+}
+
+// hasAllowedLang reports whether any language written in script passes the filters of options.
+func hasAllowedLang(script *unicode.RangeTable, options Options) bool {
+	if lang, ok := scriptLangs[script]; ok {
+		return options.isAllowed(lang)
+	}
+	for lang := range scriptProfiles[script] {
+		if options.isAllowed(lang) {
+			return true
+		}
+	}
+	return false
+}
+
+// detectFallback looks for an allowed language when none of the languages written in
+// the dominant script of the text are allowed. The other scripts of the text are tried
+// from the most to the least frequent one, and the confidence is scaled down by the
+// share of the text written in the script that was used. If no script of the text has
+// allowed languages, the text tells nothing about them: the allowed language with the
+// highest prior is returned in its own script, with a confidence of 0 and
+// ReasonScriptMismatch. The language is undetermined only when no language is allowed.
+func detectFallback(ctx context.Context, text string, options Options, dominant *unicode.RangeTable) (Info, error) {
+	counters := countScripts(text)
+	total := 0
+	for _, sc := range counters {
+		total += sc.count
+	}
+
+	for _, sc := range counters {
+		if sc.count == 0 || sc.script == dominant || !hasAllowedLang(sc.script, options) {
+			continue
+		}
+		lang, confidence, err := detectLangBaseOnScript(ctx, text, options, sc.script)
+		if err != nil {
+			return Info{}, err
+		}
+		if lang != Und {
+			return Info{
+				Lang:       lang,
+				Script:     sc.script,
+				Confidence: confidence * float64(sc.count) / float64(total),
+			}, nil
+		}
+	}
+
+	best := Und
+	for _, lang := range SupportedLanguages() {
+		if options.isAllowed(lang) && (best == Und || options.logPrior(lang) > options.logPrior(best)) {
+			best = lang
+		}
+	}
+	if best == Und {
+		return Info{
+			Lang:       Und,
+			Script:     dominant,
+			Confidence: 0,
+		}, nil
+	}
+	return Info{
+		Lang:       best,
+		Script:     ScriptsForLanguage(best)[0],
+		Confidence: 0,
+		Reason:     ReasonScriptMismatch,
+	}, nil
+}
+
+// detectSingleLang returns lang for scripts that are used by a single language,
+// unless lang is filtered out by options.
+func detectSingleLang(lang Lang, options Options) (Lang, float64) {
+	if !options.isAllowed(lang) {
+		return Und, 0
 	}
+	return lang, 1
 }
 
 type langDistance struct {
@@ -97,40 +256,178 @@ type langDistance struct {
 	dist int
 }
 
-func detectLangInProfiles(text string, options Options, langProfileList langProfileList) (Lang, float64) {
-	trigrams := getTrigramsWithPositions(text)
+func detectLangInProfiles(ctx context.Context, text string, options Options, langProfileList langKeyProfileList, dict *dictionary) (Lang, float64, error) {
+	buf := getTrigramBuffer()
+	defer putTrigramBuffer(buf)
+	trigrams := buf.rankTrigrams(text)
+	langDistances, err := closestLangs(ctx, text, trigrams, options, langProfileList, dict)
+	if err != nil {
+		return Und, 0, err
+	}
 
-	langDistances := []langDistance{}
+	switch len(langDistances) {
+	case 0:
+		return Und, 0, nil
+	case 1:
+		return langDistances[0].lang, 1, nil
+	}
 
-	for lang, langTrigrams := range langProfileList {
-		if len(options.Whitelist) != 0 {
-			//Skip non-whitelisted languages.
-			if _, ok := options.Whitelist[lang]; !ok {
-				continue
+	lang, confidence := calculateConfidence(langDistances, len(trigrams))
+	if lang != Und && options.isShortText(len(trigrams)) {
+		confidence *= shortTextEvidence(text, len(trigrams), options, lang, dict)
+	}
+	return lang, confidence, nil
+}
+
+// closestLangs returns the two languages rankText ranks first, with the same distances,
+// or fewer languages when fewer are allowed. It stops summing up the distance of a
+// language as soon as the language is sure to be farther than the second closest one so
+// far: trigram distances only grow, priors only add to them up to maxTotalDistance, and
+// the words of the language remove a known distance, at most maxWordDistance. It returns
+// the error of ctx when ctx is done before a language is compared.
+func closestLangs(ctx context.Context, text string, trigrams map[uint64]int, options Options, langProfileList langKeyProfileList, dict *dictionary) ([]langDistance, error) {
+	var scale, maxLogPrior float64
+	priors := len(options.Priors) != 0
+	if priors {
+		scale = options.calibration().Scale
+		maxLogPrior = math.Inf(-1)
+		for lang := range langProfileList {
+			if options.isAllowed(lang) {
+				maxLogPrior = math.Max(maxLogPrior, options.logPrior(lang))
 			}
-		} else if len(options.Blacklist) != 0 {
-			//skip blacklisted languages.
-			if _, ok := options.Blacklist[lang]; ok {
-				continue
+		}
+	}
+	var matches wordMatches
+	if dict != nil {
+		matches = dict.match(textWords(text))
+	}
+	short := options.isShortText(len(trigrams))
+
+	closest := make([]langDistance, 0, 2)
+	for lang, langTrigrams := range langProfileList {
+		if !options.isAllowed(lang) {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var shift int
+		if priors && scale > 0 {
+			shift = priorShift(options, lang, scale, maxLogPrior)
+		}
+		wordDist := matches.distance(lang)
+		limit := noDistanceLimit
+		if len(closest) == 2 && maxTotalDistance-wordDist > closest[1].dist {
+			limit = closest[1].dist + wordDist - shift
+		}
+
+		dist, ok := calculateDistanceWithin(langTrigrams, trigrams, short, limit)
+		if !ok {
+			continue
+		}
+		if priors && scale > 0 {
+			dist += shift
+			if dist > maxTotalDistance {
+				dist = maxTotalDistance
 			}
 		}
+		closest = insertLangDistance(closest, langDistance{lang, dist - wordDist})
+	}
+	return closest, nil
+}
+
+// insertLangDistance inserts langDist into closest, which holds at most the two closest
+// languages sorted like sortLangDistances.
+func insertLangDistance(closest []langDistance, langDist langDistance) []langDistance {
+	i := len(closest)
+	for i > 0 && (langDist.dist < closest[i-1].dist || langDist.dist == closest[i-1].dist && langDist.lang < closest[i-1].lang) {
+		i--
+	}
+	if i == 2 {
+		return closest
+	}
+	if len(closest) < 2 {
+		closest = append(closest, langDistance{})
+	}
+	copy(closest[i+1:], closest[i:])
+	closest[i] = langDist
+	return closest
+}
+
+// rankLangs returns the distances between the text trigrams and the profiles of the
+// allowed languages, from the closest to the farthest language. Languages at the same
+// distance are sorted by their ISO 639-3 code, so that ties are always broken the same way.
+func rankLangs(trigrams map[uint64]int, options Options, langProfileList langKeyProfileList) []langDistance {
+	langDistances := []langDistance{}
+	distance := calculateDistance
+	if options.isShortText(len(trigrams)) {
+		distance = calculateShortTextDistance
+	}
+
+	for lang, langTrigrams := range langProfileList {
+		//Skip non-whitelisted or blacklisted languages.
+		if !options.isAllowed(lang) {
+			continue
+		}
 
-		dist := calculateDistance(langTrigrams, trigrams)
+		dist := distance(langTrigrams, trigrams)
 		langDistances = append(langDistances, langDistance{lang, dist})
 	}
 
-	switch len(langDistances) {
-	case 0:
-		return -1, 0
-	case 1:
-		return langDistances[0].lang, 1
-	default:
-		return calculateConfidence(langDistances, trigrams)
+	if len(options.Priors) != 0 {
+		applyPriors(langDistances, options)
+	}
+
+	sortLangDistances(langDistances)
+	return langDistances
+}
+
+// sortLangDistances sorts langDistances from the closest to the farthest language, and
+// languages at the same distance by their ISO 639-3 code.
+func sortLangDistances(langDistances []langDistance) {
+	sort.Slice(langDistances, func(i, j int) bool {
+		if langDistances[i].dist == langDistances[j].dist {
+			return langDistances[i].lang < langDistances[j].lang
+		}
+		return langDistances[i].dist < langDistances[j].dist
+	})
+}
+
+// applyPriors adds to the distance of every language the distance that corresponds to
+// the ratio between its prior and the highest prior, according to the calibration:
+// the posterior probability of a language is proportional to its prior times its
+// calibrated probability. Distances only increase, so that priors alone never make
+// a language match, and the more the text tells languages apart, the less priors matter.
+func applyPriors(langDistances []langDistance, options Options) {
+	scale := options.calibration().Scale
+	if scale <= 0 {
+		return
+	}
+
+	maxLogPrior := math.Inf(-1)
+	for _, langDist := range langDistances {
+		maxLogPrior = math.Max(maxLogPrior, options.logPrior(langDist.lang))
+	}
+
+	for i, langDist := range langDistances {
+		dist := langDist.dist + priorShift(options, langDist.lang, scale, maxLogPrior)
+		if dist > maxTotalDistance {
+			dist = maxTotalDistance
+		}
+		langDistances[i].dist = dist
 	}
 }
 
-func calculateConfidence(langDistances []langDistance, trigrams map[string]int) (Lang, float64) {
-	sort.SliceStable(langDistances, func(i, j int) bool { return langDistances[i].dist < langDistances[j].dist })
+// priorShift returns the distance applyPriors adds to lang, given the scale of the
+// calibration and the highest logarithm of the priors of the allowed languages.
+func priorShift(options Options, lang Lang, scale, maxLogPrior float64) int {
+	return int(math.Round((maxLogPrior - options.logPrior(lang)) / scale * maxTrigramDistance))
+}
+
+// calculateConfidence returns the closest language and the confidence of the detection.
+// langDistances holds at least two languages and is sorted as returned by rankLangs.
+func calculateConfidence(langDistances []langDistance, trigramCount int) (Lang, float64) {
 	langDist1 := langDistances[0]
 	langDist2 := langDistances[1]
 	score1 := maxTotalDistance - langDist1.dist
@@ -140,7 +437,7 @@ func calculateConfidence(langDistances []langDistance, trigrams map[string]int)
 	if score1 == 0 {
 		// If score1 is 0, score2 is 0 as well, because array is sorted.
 		// Therefore there is no language to return.
-		return -1, 0
+		return Und, 0
 	} else if score2 == 0 {
 		// If score2 is 0, return first language, to prevent division by zero in the rate formula.
 		// In this case confidence is calculated by another formula.
@@ -162,7 +459,7 @@ func calculateConfidence(langDistances []langDistance, trigrams map[string]int)
 	// If rate is below, confidence is calculated proportionally.
 	// Numbers 12.0 and 0.05 are obtained experimentally, so the function represents common sense.
 
-	confidentRate := float64(12.0/float64(len(trigrams))) + 0.05
+	confidentRate := float64(12.0/float64(trigramCount)) + 0.05
 	if rate > confidentRate {
 		confidence = 1.0
 	} else {
@@ -172,7 +469,7 @@ func calculateConfidence(langDistances []langDistance, trigrams map[string]int)
 	return langDist1.lang, confidence
 }
 
-func calculateDistance(langTrigrams []string, textTrigrams map[string]int) int {
+func calculateDistance(langTrigrams []uint64, textTrigrams map[uint64]int) int {
 	var dist, totalDist int
 	for i, trigram := range langTrigrams {
 		if n, ok := textTrigrams[trigram]; ok {
@@ -185,3 +482,53 @@ func calculateDistance(langTrigrams []string, textTrigrams map[string]int) int {
 
 	return totalDist
 }
+
+// noDistanceLimit is a limit of calculateDistanceWithin that no distance reaches.
+const noDistanceLimit = math.MaxInt32
+
+// calculateDistanceWithin returns the distance calculateDistance, or
+// calculateShortTextDistance for short texts, computes, unless it exceeds limit, in which
+// case it returns false as soon as it is sure to: the trigrams of the profile left to
+// compare that outnumber the trigrams of the text left to find each add
+// maxTrigramDistance.
+func calculateDistanceWithin(langTrigrams []uint64, textTrigrams map[uint64]int, short bool, limit int) (int, bool) {
+	var dist, totalDist, matched int
+	for i, trigram := range langTrigrams {
+		n, ok := textTrigrams[trigram]
+		switch {
+		case !ok:
+			dist = maxTrigramDistance
+		case short:
+			dist = i / 2
+			matched++
+		default:
+			dist = abs(n - i)
+			matched++
+		}
+		totalDist += dist
+		if missing := len(langTrigrams) - i - 1 - (len(textTrigrams) - matched); missing > 0 {
+			if totalDist+missing*maxTrigramDistance > limit {
+				return totalDist, false
+			}
+		} else if totalDist > limit {
+			return totalDist, false
+		}
+	}
+	return totalDist, true
+}
+
+// calculateNgramDistance is calculateDistance for n-grams of any order given as
+// strings, where an n-gram missing from the text adds maxDistance.
+func calculateNgramDistance(langNgrams []string, textNgrams map[string]int, maxDistance int) int {
+	var dist, totalDist int
+	for i, ngram := range langNgrams {
+		if n, ok := textNgrams[ngram]; ok {
+			dist = abs(n - i)
+		} else {
+			dist = maxDistance
+		}
+		totalDist += dist
+	}
+
+	return totalDist
+}
@@ -1,44 +1,46 @@
 package whatlanggo
 
 import (
+	"context"
 	"encoding/json"
 	"io/ioutil"
-	"os"
+	"reflect"
+	"strings"
 	"testing"
 	"unicode"
 )
 
 func TestDetect(t *testing.T) {
 	tests := map[string]Info{
-		"Además de todo lo anteriormente dicho, también encontramos...": {Spa, unicode.Latin, 1},
-		"बहुत बहुत (धन्यवाद / शुक्रिया)!":                               {Hin, unicode.Devanagari, 1},
-		"अनुच्छेद १: सबहि लोकानि आजादे जम्मेला आओर ओखिनियो के बराबर सम्मान आओर अघ्कार प्राप्त हवे। ओखिनियो के पास समझ-बूझ आओर अंत:करण के आवाज होखता आओर हुनको के दोसरा के साथ भाईचारे के बेवहार करे के होखला": {Bho, unicode.Devanagari, 1},
-		"ኢትዮጵያ አፍሪቃ ውስጥ ናት":         {Amh, unicode.Ethiopic, 1},
-		"لغتي العربية ليست كما يجب": {Arb, unicode.Arabic, 1},
-		"我爱你": {Cmn, unicode.Han, 1},
-		"আমি তোমাকে ভালোবাস ": {Ben, unicode.Bengali, 1},
-		"울란바토르": {Kor, unicode.Hangul, 1},
-		"ყველა ადამიანი იბადება თავისუფალი და თანასწორი თავისი ღირსებითა და უფლებებით":        {Kat, unicode.Georgian, 1},
-		"Όλοι οι άνθρωποι γεννιούνται ελεύθεροι και ίσοι στην αξιοπρέπεια και τα δικαιώματα.": {Ell, unicode.Greek, 1},
-		"ಎಲ್ಲಾ ಮಾನವರ ಉಚಿತ ಮತ್ತು ಘನತೆ ಮತ್ತು ಹಕ್ಕುಗಳಲ್ಲಿ ಸಮಾನ ಹುಟ್ಟಿದ.":                         {Kan, unicode.Kannada, 1},
-		"நீங்கள் ஆங்கிலம் பேசுவீர்களா?":                                                       {Tam, unicode.Tamil, 1},
-		"มนุษย์ทุกคนเกิดมามีอิสระและเสมอภาคกันในศักดิ์ศรีและสิทธิ":                            {Tha, unicode.Thai, 1},
-		"નાણાં મારા લોહીમાં છે":    {Guj, unicode.Gujarati, 1},
-		" ਗੁਰੂ ਗ੍ਰੰਥ ਸਾਹਿਬ ਜੀ":     {Pan, unicode.Gurmukhi, 1},
-		"నన్ను ఒంటరిగా వదిలేయ్":    {Tel, unicode.Telugu, 1},
-		"എന്താണ് നിങ്ങളുടെ പേര് ?": {Mal, unicode.Malayalam, 1},
-		"ମୁ ତୁମକୁ ଭଲ ପାଏ |":        {Ori, unicode.Oriya, 1},
-		"အားလုံးလူသားတွေအခမဲ့နှင့်ဂုဏ်သိက္ခာနှင့်လူ့အခွင့်အရေးအတွက်တန်းတူဖွားမြင်ကြသည်။": {Mya, unicode.Myanmar, 1},
-		"වෙලාව කියද?":                        {Sin, unicode.Sinhala, 1},
-		"ពួកម៉ាកខ្ញុំពីរនាក់នេះ":             {Khm, unicode.Khmer, 1},
-		"其疾如風、其徐如林、侵掠如火、不動如山、難知如陰、動如雷震。":     {Cmn, unicode.Han, 1},
-		"知彼知己、百戰不殆。不知彼而知己、一勝一負。不知彼不知己、毎戰必殆。": {Cmn, unicode.Han, 1},
-		"支那の上海の或町です。":                        {Jpn, _HiraganaKatakana, 1},
-		"或日の暮方の事である。":                        {Jpn, _HiraganaKatakana, 1},
-		"今日は":                                {Jpn, _HiraganaKatakana, 1},
-		"コンニチハ":                              {Jpn, _HiraganaKatakana, 1},
-		"ﾀﾅｶ ﾀﾛｳ":                            {Jpn, _HiraganaKatakana, 1},
-		"どうもありがとう":                           {Jpn, _HiraganaKatakana, 1},
+		"Además de todo lo anteriormente dicho, también encontramos...": {Lang: Spa, Script: unicode.Latin, Confidence: 1},
+		"बहुत बहुत (धन्यवाद / शुक्रिया)!":                               {Lang: Hin, Script: unicode.Devanagari, Confidence: 1},
+		"अनुच्छेद १: सबहि लोकानि आजादे जम्मेला आओर ओखिनियो के बराबर सम्मान आओर अघ्कार प्राप्त हवे। ओखिनियो के पास समझ-बूझ आओर अंत:करण के आवाज होखता आओर हुनको के दोसरा के साथ भाईचारे के बेवहार करे के होखला": {Lang: Bho, Script: unicode.Devanagari, Confidence: 1},
+		"ኢትዮጵያ አፍሪቃ ውስጥ ናት":         {Lang: Amh, Script: unicode.Ethiopic, Confidence: 1},
+		"لغتي العربية ليست كما يجب": {Lang: Arb, Script: unicode.Arabic, Confidence: 1},
+		"我爱你": {Lang: Cmn, Script: unicode.Han, Confidence: 1},
+		"আমি তোমাকে ভালোবাস ": {Lang: Ben, Script: unicode.Bengali, Confidence: 1},
+		"울란바토르": {Lang: Kor, Script: unicode.Hangul, Confidence: 1},
+		"ყველა ადამიანი იბადება თავისუფალი და თანასწორი თავისი ღირსებითა და უფლებებით":        {Lang: Kat, Script: unicode.Georgian, Confidence: 1},
+		"Όλοι οι άνθρωποι γεννιούνται ελεύθεροι και ίσοι στην αξιοπρέπεια και τα δικαιώματα.": {Lang: Ell, Script: unicode.Greek, Confidence: 1},
+		"ಎಲ್ಲಾ ಮಾನವರ ಉಚಿತ ಮತ್ತು ಘನತೆ ಮತ್ತು ಹಕ್ಕುಗಳಲ್ಲಿ ಸಮಾನ ಹುಟ್ಟಿದ.":                         {Lang: Kan, Script: unicode.Kannada, Confidence: 1},
+		"நீங்கள் ஆங்கிலம் பேசுவீர்களா?":                                                       {Lang: Tam, Script: unicode.Tamil, Confidence: 1},
+		"มนุษย์ทุกคนเกิดมามีอิสระและเสมอภาคกันในศักดิ์ศรีและสิทธิ":                            {Lang: Tha, Script: unicode.Thai, Confidence: 1},
+		"નાણાં મારા લોહીમાં છે":    {Lang: Guj, Script: unicode.Gujarati, Confidence: 1},
+		" ਗੁਰੂ ਗ੍ਰੰਥ ਸਾਹਿਬ ਜੀ":     {Lang: Pan, Script: unicode.Gurmukhi, Confidence: 1},
+		"నన్ను ఒంటరిగా వదిలేయ్":    {Lang: Tel, Script: unicode.Telugu, Confidence: 1},
+		"എന്താണ് നിങ്ങളുടെ പേര് ?": {Lang: Mal, Script: unicode.Malayalam, Confidence: 1},
+		"ମୁ ତୁମକୁ ଭଲ ପାଏ |":        {Lang: Ori, Script: unicode.Oriya, Confidence: 1},
+		"အားလုံးလူသားတွေအခမဲ့နှင့်ဂုဏ်သိက္ခာနှင့်လူ့အခွင့်အရေးအတွက်တန်းတူဖွားမြင်ကြသည်။": {Lang: Mya, Script: unicode.Myanmar, Confidence: 1},
+		"වෙලාව කියද?":                        {Lang: Sin, Script: unicode.Sinhala, Confidence: 1},
+		"ពួកម៉ាកខ្ញុំពីរនាក់នេះ":             {Lang: Khm, Script: unicode.Khmer, Confidence: 1},
+		"其疾如風、其徐如林、侵掠如火、不動如山、難知如陰、動如雷震。":     {Lang: Cmn, Script: unicode.Han, Confidence: 1},
+		"知彼知己、百戰不殆。不知彼而知己、一勝一負。不知彼不知己、毎戰必殆。": {Lang: Cmn, Script: unicode.Han, Confidence: 1},
+		"支那の上海の或町です。":                        {Lang: Jpn, Script: _HiraganaKatakana, Confidence: 1},
+		"或日の暮方の事である。":                        {Lang: Jpn, Script: _HiraganaKatakana, Confidence: 1},
+		"今日は":                                {Lang: Jpn, Script: _HiraganaKatakana, Confidence: 1},
+		"コンニチハ":                              {Lang: Jpn, Script: _HiraganaKatakana, Confidence: 1},
+		"ﾀﾅｶ ﾀﾛｳ":                            {Lang: Jpn, Script: _HiraganaKatakana, Confidence: 1},
+		"どうもありがとう":                           {Lang: Jpn, Script: _HiraganaKatakana, Confidence: 1},
 	}
 
 	for key, value := range tests {
@@ -70,7 +72,7 @@ func TestDetectLang(t *testing.T) {
 
 // Test detect with empty options and supported language and script
 func TestDetectWithOptionsEmptySupportedLang(t *testing.T) {
-	want := Info{Epo, unicode.Latin, 1}
+	want := Info{Lang: Epo, Script: unicode.Latin, Confidence: 1}
 	got := DetectWithOptions("La viro amas hundojn. Hundo estas la plej bona amiko de viro", Options{})
 	if want.Lang != got.Lang && want.Script != got.Script {
 		t.Fatalf("want %v %v got %v %v", want.Lang, want.Script, got.Lang, got.Script)
@@ -79,7 +81,7 @@ func TestDetectWithOptionsEmptySupportedLang(t *testing.T) {
 
 // Test detect with empty options and nonsupported script(Balinese)
 func TestDetectWithOptionsEmptyNonSupportedLang(t *testing.T) {
-	want := Info{-1, nil, 0}
+	want := Info{Lang: Und, Script: nil, Confidence: 0}
 	got := DetectWithOptions("ᬅᬓ᭄ᬱᬭᬯ᭄ᬬᬜ᭄ᬚᬦ", Options{})
 	if want.Lang != got.Lang && want.Script != got.Script {
 		t.Fatalf("want %v %v got %v %v", want.Lang, want.Script, got.Lang, got.Script)
@@ -95,14 +97,14 @@ func TestDetectWithOptionsWithBlacklist(t *testing.T) {
 			Ydd: true,
 		},
 	}
-	want := Info{-1, unicode.Hebrew, 1}
+	want := Info{Lang: Und, Script: unicode.Hebrew, Confidence: 1}
 	got := DetectWithOptions(text, options1)
 	if got.Lang != want.Lang && want.Script != got.Script {
 		t.Fatalf("Want %s %s got %s %s", LangToString(want.Lang), Scripts[want.Script], LangToString(got.Lang), Scripts[got.Script])
 	}
 
 	text = "Tu me manques"
-	want = Info{Fra, unicode.Latin, 1}
+	want = Info{Lang: Fra, Script: unicode.Latin, Confidence: 1}
 	options3 := Options{
 		Blacklist: map[Lang]bool{
 			Kur: true,
@@ -116,7 +118,7 @@ func TestDetectWithOptionsWithBlacklist(t *testing.T) {
 
 func TestWithOptionsWithWhitelist(t *testing.T) {
 	text := "Mi ne scias!"
-	want := Info{Epo, unicode.Latin, 1}
+	want := Info{Lang: Epo, Script: unicode.Latin, Confidence: 1}
 	options2 := Options{
 		Whitelist: map[Lang]bool{
 			Epo: true,
@@ -158,37 +160,416 @@ func TestDetectLangWithOptions(t *testing.T) {
 }
 
 func Test_detectLangBaseOnScriptUnsupportedScript(t *testing.T) {
-	want := Info{-1, nil, 0}
-	gotLang, gotConfidence := detectLangBaseOnScript("ᬅᬓ᭄ᬱᬭᬯ᭄ᬬᬜ᭄ᬚᬦ", Options{}, unicode.Balinese)
+	want := Info{Lang: Und, Script: nil, Confidence: 0}
+	gotLang, gotConfidence, _ := detectLangBaseOnScript(context.Background(), "ᬅᬓ᭄ᬱᬭᬯ᭄ᬬᬜ᭄ᬚᬦ", Options{}, unicode.Balinese)
 	if want.Lang != gotLang && want.Confidence != gotConfidence {
 		t.Fatalf("want %v %v got %v %v", want.Lang, want.Script, gotLang, gotConfidence)
 	}
 }
 
 func TestWithMultipleExamples(t *testing.T) {
-	examplesFile, err := os.Open("testdata/examples.json")
+	examples := readExamples(t)
+
+	for lang, text := range examples {
+		want := CodeToLang(lang)
+		info := Detect(text)
+		if info.Lang != want && !info.IsReliable() {
+			t.Fatalf("want %v, got %v", Langs[want], Langs[info.Lang])
+		}
+	}
+}
+
+// readExamples returns the texts of testdata/examples.json by ISO 639-3 code.
+func readExamples(t *testing.T) map[string]string {
+	data, err := ioutil.ReadFile("testdata/examples.json")
 	if err != nil {
-		t.Fatal("Error opening testdata/examples.json")
+		t.Fatal(err)
+	}
+	var examples map[string]string
+	if err := json.Unmarshal(data, &examples); err != nil {
+		t.Fatal(err)
+	}
+	return examples
+}
+
+func TestDetectWithOptionsFiltersEveryScript(t *testing.T) {
+	tests := []struct {
+		text   string
+		script *unicode.RangeTable
+		lang   Lang
+	}{
+		{"Además de todo lo anteriormente dicho, también encontramos...", unicode.Latin, Spa},
+		{"Та нічого, все нормально. А в тебе як?", unicode.Cyrillic, Ukr},
+		{"बहुत बहुत (धन्यवाद / शुक्रिया)!", unicode.Devanagari, Hin},
+		{"האקדמיה ללשון העברית", unicode.Hebrew, Heb},
+		{"ኢትዮጵያ አፍሪቃ ውስጥ ናት", unicode.Ethiopic, Amh},
+		{"لغتي العربية ليست كما يجب", unicode.Arabic, Arb},
+		{"我爱你", unicode.Han, Cmn},
+		{"আমি তোমাকে ভালোবাস ", unicode.Bengali, Ben},
+		{"울란바토르", unicode.Hangul, Kor},
+		{"ყველა ადამიანი იბადება თავისუფალი და თანასწორი", unicode.Georgian, Kat},
+		{"Όλοι οι άνθρωποι γεννιούνται ελεύθεροι", unicode.Greek, Ell},
+		{"ಎಲ್ಲಾ ಮಾನವರ ಉಚಿತ ಮತ್ತು ಘನತೆ", unicode.Kannada, Kan},
+		{"நீங்கள் ஆங்கிலம் பேசுவீர்களா?", unicode.Tamil, Tam},
+		{"มนุษย์ทุกคนเกิดมามีอิสระและเสมอภาคกัน", unicode.Thai, Tha},
+		{"નાણાં મારા લોહીમાં છે", unicode.Gujarati, Guj},
+		{" ਗੁਰੂ ਗ੍ਰੰਥ ਸਾਹਿਬ ਜੀ", unicode.Gurmukhi, Pan},
+		{"నన్ను ఒంటరిగా వదిలేయ్", unicode.Telugu, Tel},
+		{"എന്താണ് നിങ്ങളുടെ പേര് ?", unicode.Malayalam, Mal},
+		{"ମୁ ତୁମକୁ ଭଲ ପାଏ |", unicode.Oriya, Ori},
+		{"အားလုံးလူသားတွေအခမဲ့နှင့်ဂုဏ်သိက္ခာနှင့်", unicode.Myanmar, Mya},
+		{"වෙලාව කියද?", unicode.Sinhala, Sin},
+		{"ពួកម៉ាកខ្ញុំពីរនាក់នេះ", unicode.Khmer, Khm},
+		{"どうもありがとう", _HiraganaKatakana, Jpn},
 	}
 
-	defer examplesFile.Close()
+	for _, tt := range tests {
+		other := Eng
+		if tt.script == unicode.Latin {
+			other = Rus
+		}
 
-	byteValue, err := ioutil.ReadAll(examplesFile)
-	if err != nil {
-		t.Fatal("Error reading testdata/examples.json")
+		//Whitelisting the expected language keeps it.
+		got := DetectWithOptions(tt.text, Options{Whitelist: map[Lang]bool{tt.lang: true}})
+		if got.Lang != tt.lang || got.Script != tt.script {
+			t.Fatalf("%s whitelisted: want %v %v got %v %v", tt.text, LangToString(tt.lang), Scripts[tt.script], LangToString(got.Lang), Scripts[got.Script])
+		}
+
+		//Blacklisting the expected language never returns it.
+		got = DetectWithOptions(tt.text, Options{Blacklist: map[Lang]bool{tt.lang: true}})
+		if got.Lang == tt.lang || got.Script != tt.script {
+			t.Fatalf("%s blacklisted: got %v %v", tt.text, LangToString(got.Lang), Scripts[got.Script])
+		}
+
+		//Whitelisting only a language of another script returns no language.
+		got = DetectWithOptions(tt.text, Options{Whitelist: map[Lang]bool{other: true}})
+		if got.Lang != -1 || got.Confidence != 0 || got.Script != tt.script {
+			t.Fatalf("%s whitelisted %v: got %v %v %v", tt.text, LangToString(other), LangToString(got.Lang), Scripts[got.Script], got.Confidence)
+		}
 	}
+}
 
-	var examples map[string]string
-	err = json.Unmarshal(byteValue, &examples)
-	if err != nil {
-		t.Fatal("Error Unmarshalling json")
+func TestOptionsIsAllowed(t *testing.T) {
+	tests := []struct {
+		options Options
+		lang    Lang
+		want    bool
+	}{
+		{Options{}, Cmn, true},
+		{Options{Whitelist: map[Lang]bool{Eng: true}}, Cmn, false},
+		{Options{Whitelist: map[Lang]bool{Eng: true}}, Eng, true},
+		{Options{Blacklist: map[Lang]bool{Cmn: true}}, Cmn, false},
+		{Options{Blacklist: map[Lang]bool{Cmn: true}}, Eng, true},
+		{Options{Whitelist: map[Lang]bool{Cmn: true}, Blacklist: map[Lang]bool{Cmn: true}}, Cmn, true},
 	}
 
-	for lang, text := range examples {
-		want := CodeToLang(lang)
-		info := Detect(text)
-		if info.Lang != want && !info.IsReliable() {
-			t.Fatalf("want %v, got %v", Langs[want], Langs[info.Lang])
+	for _, tt := range tests {
+		got := tt.options.isAllowed(tt.lang)
+		if got != tt.want {
+			t.Fatalf("%v %s want %t got %t", tt.options, LangToString(tt.lang), tt.want, got)
+		}
+	}
+}
+
+func TestDetectWithOptionsScriptFallback(t *testing.T) {
+	whitelist := map[Lang]bool{Eng: true, Spa: true}
+
+	text := "Привет! Текст на русском языке with some English words."
+	got := DetectWithOptions(text, Options{Whitelist: whitelist})
+	if got.Lang != -1 {
+		t.Fatalf("without fallback want no language got %v", LangToString(got.Lang))
+	}
+
+	got = DetectWithOptions(text, Options{Whitelist: whitelist, ScriptFallback: true})
+	if got.Lang != Eng || got.Script != unicode.Latin {
+		t.Fatalf("want %v %v got %v %v", LangToString(Eng), Scripts[unicode.Latin], LangToString(got.Lang), Scripts[got.Script])
+	}
+	if got.Confidence <= 0 || got.Confidence >= 0.5 {
+		t.Fatalf("want confidence scaled by the Latin share of the text, got %v", got.Confidence)
+	}
+
+	//No allowed language is written in any script of the text: the allowed language
+	//with the highest prior is returned in its own script, without confidence.
+	cyrillic := "Привет! Текст на русском языке."
+	tests := []struct {
+		options Options
+		want    Lang
+	}{
+		{Options{Whitelist: whitelist, ScriptFallback: true}, Eng},
+		{Options{Whitelist: whitelist, ScriptFallback: true, Priors: map[Lang]float64{Spa: 2}}, Spa},
+		{Options{Whitelist: map[Lang]bool{Jpn: true, Spa: true}, ScriptFallback: true, Priors: map[Lang]float64{Jpn: 2}}, Jpn},
+	}
+	for _, tt := range tests {
+		got = DetectWithOptions(cyrillic, tt.options)
+		if got.Lang != tt.want || got.Reason != ReasonScriptMismatch || got.Confidence != 0 || got.Script != ScriptsForLanguage(tt.want)[0] {
+			t.Fatalf("%v: want %v %v with %v got %v", tt.options, LangToString(tt.want), Scripts[ScriptsForLanguage(tt.want)[0]], ReasonScriptMismatch, got)
+		}
+	}
+
+	//All languages written in the script of the text are blacklisted.
+	blacklist := map[Lang]bool{}
+	for lang := range cyrillicLangs {
+		blacklist[lang] = true
+	}
+	got = DetectWithOptions(cyrillic, Options{Blacklist: blacklist, ScriptFallback: true})
+	if got.Lang != Afr || got.Script != unicode.Latin || got.Reason != ReasonScriptMismatch || got.Confidence != 0 {
+		t.Fatalf("want %v with %v got %v", LangToString(Afr), ReasonScriptMismatch, got)
+	}
+
+	//Japanese falls back to the Han characters it contains.
+	got = DetectWithOptions("支那の上海の或町です。", Options{Whitelist: map[Lang]bool{Cmn: true}, ScriptFallback: true})
+	if got.Lang != Cmn || got.Script != unicode.Han {
+		t.Fatalf("want %v %v got %v %v", LangToString(Cmn), Scripts[unicode.Han], LangToString(got.Lang), Scripts[got.Script])
+	}
+
+	//The fallback is not used when the dominant script has allowed languages.
+	got = DetectWithOptions("Where there is a will there is a way", Options{Whitelist: whitelist, ScriptFallback: true})
+	if got.Lang != Eng || got.Script != unicode.Latin {
+		t.Fatalf("want %v %v got %v %v", LangToString(Eng), Scripts[unicode.Latin], LangToString(got.Lang), Scripts[got.Script])
+	}
+}
+
+func TestDetectReason(t *testing.T) {
+	tests := []struct {
+		text    string
+		options Options
+		want    Reason
+	}{
+		{"Where there is a will there is a way", Options{}, ReasonNone},
+		{"", Options{}, ReasonEmptyText},
+		{"123456789-=? !", Options{}, ReasonNoLetters},
+		{"ᬅᬓ᭄ᬱᬭᬯ᭄ᬬᬜ᭄ᬚᬦ", Options{}, ReasonUnsupportedScript},
+		{"我爱你", Options{Blacklist: map[Lang]bool{Cmn: true}}, ReasonFiltered},
+		{"האקדמיה ללשון העברית", Options{Blacklist: map[Lang]bool{Heb: true, Ydd: true}}, ReasonFiltered},
+		{"Та нічого, all right", Options{Whitelist: map[Lang]bool{Eng: true}, ScriptFallback: true}, ReasonNone},
+		{"Та нічого", Options{Whitelist: map[Lang]bool{Eng: true}, ScriptFallback: true}, ReasonScriptMismatch},
+		{"Та нічого", Options{Whitelist: map[Lang]bool{Und: true}, ScriptFallback: true}, ReasonFiltered},
+		{"xqzxqz", Options{}, ReasonBelowThreshold},
+	}
+
+	for _, tt := range tests {
+		got := DetectWithOptions(tt.text, tt.options)
+		if got.Reason != tt.want {
+			t.Fatalf("%q want %v got %v", tt.text, tt.want, got.Reason)
+		}
+		if (got.Reason == ReasonNone || got.Reason == ReasonScriptMismatch) != got.Lang.IsValid() {
+			t.Fatalf("%q got %v with reason %v", tt.text, LangToString(got.Lang), got.Reason)
+		}
+	}
+}
+
+func TestDetectTieBreaking(t *testing.T) {
+	tests := []struct {
+		text    string
+		options Options
+		want    Lang
+	}{
+		//Ces and Hun are equally close.
+		{"a", Options{}, Ces},
+		//Afr and Nld are equally close.
+		{"en", Options{}, Afr},
+		{"en", Options{Whitelist: map[Lang]bool{Nld: true, Afr: true, Eng: true}}, Afr},
+	}
+
+	for _, tt := range tests {
+		langDistances := rankLangs(packTrigrams(getTrigramsWithPositions(tt.text)), tt.options, scriptProfileKeys[unicode.Latin])
+		if langDistances[0].dist != langDistances[1].dist {
+			t.Fatalf("%q: %v and %v are not tied", tt.text, LangToString(langDistances[0].lang), LangToString(langDistances[1].lang))
+		}
+
+		for i := 0; i < 100; i++ {
+			got := DetectLangWithOptions(tt.text, tt.options)
+			if got != tt.want {
+				t.Fatalf("%q run %d: want %v got %v", tt.text, i, LangToString(tt.want), LangToString(got))
+			}
+		}
+	}
+}
+
+func TestDetectWithOptionsThresholds(t *testing.T) {
+	//Detected as French with a confidence of about 0.55.
+	text := "Vouloir, c'est pouvoir"
+
+	tests := []struct {
+		options  Options
+		reliable bool
+	}{
+		{Options{}, false},
+		{Options{ReliabilityThreshold: 0.5}, true},
+		{Options{ReliabilityThreshold: 0.5, LangThresholds: map[Lang]float64{Fra: 0.6}}, false},
+		{Options{LangThresholds: map[Lang]float64{Fra: 0.1, Eng: 0.9}}, true},
+		{Options{LangThresholds: map[Lang]float64{Eng: 0.1}}, false},
+		//A threshold of 0 makes every detection of the language reliable.
+		{Options{LangThresholds: map[Lang]float64{Fra: 0}}, true},
+		{Options{ReliabilityThreshold: 0.9, LangThresholds: map[Lang]float64{Fra: 0}}, true},
+	}
+
+	for _, tt := range tests {
+		info := DetectWithOptions(text, tt.options)
+		if info.Lang != Fra || tt.options.IsReliable(info) != tt.reliable {
+			t.Fatalf("%v: want %v reliable %t got %v reliable %t (%v)", tt.options, LangToString(Fra), tt.reliable, LangToString(info.Lang), tt.options.IsReliable(info), info.Confidence)
+		}
+		if info != Detect(text) {
+			t.Fatalf("%v: want the same Info as without thresholds got %v", tt.options, info)
+		}
+
+		tt.options.RejectUnreliable = true
+		info = DetectWithOptions(text, tt.options)
+		if tt.reliable && info.Lang != Fra {
+			t.Fatalf("%v: want %v got %v", tt.options, LangToString(Fra), LangToString(info.Lang))
+		}
+		if !tt.reliable && (info.Lang != Und || info.Reason != ReasonBelowThreshold || info.Script != unicode.Latin || info.Confidence != 0) {
+			t.Fatalf("%v: want rejection got %v", tt.options, info)
+		}
+	}
+
+	//Reliable detections are kept.
+	info := DetectWithOptions("Where there is a will there is a way", Options{RejectUnreliable: true})
+	if info.Lang != Eng || !info.IsReliable() {
+		t.Fatalf("want %v got %v", LangToString(Eng), info)
+	}
+}
+
+func TestDetectWithOptionsPriors(t *testing.T) {
+	tests := []struct {
+		text   string
+		priors map[Lang]float64
+		want   Lang
+	}{
+		//Detected as Spanish without priors.
+		{"Como estás tu hoje", nil, Spa},
+		{"Como estás tu hoje", map[Lang]float64{Por: 10}, Por},
+		{"Como estás tu hoje", map[Lang]float64{Spa: 0.1}, Por},
+		//Priors do not override strong evidence.
+		{"La casa es grande", map[Lang]float64{Por: 10}, Spa},
+		{"Ĉu vi ne volas eklerni Esperanton? Bonvolu! Estas unu de la plej bonaj aferoj!", map[Lang]float64{Spa: 100, Por: 100}, Epo},
+		//Non-positive priors are ignored.
+		{"Como estás tu hoje", map[Lang]float64{Spa: 0, Por: -1}, Spa},
+		//Priors alone never make a language match.
+		{"qzx", map[Lang]float64{Eng: 1000}, Und},
+	}
+
+	for _, tt := range tests {
+		got := DetectLangWithOptions(tt.text, Options{Priors: tt.priors})
+		if got != tt.want {
+			t.Fatalf("%q %v: want %v got %v", tt.text, tt.priors, LangToString(tt.want), LangToString(got))
+		}
+	}
+}
+
+func BenchmarkDetect(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Detect(benchmarkText)
+	}
+}
+
+func TestClosestLangs(t *testing.T) {
+	examples := readExamples(t)
+
+	optionSets := []Options{
+		{},
+		{ShortTextTrigrams: -1},
+		{Priors: map[Lang]float64{Nob: 5, Dan: 0.5, Por: 2}},
+		{Whitelist: map[Lang]bool{Nob: true, Dan: true, Nno: true}},
+		{Blacklist: map[Lang]bool{Eng: true}},
+	}
+
+	buf := getTrigramBuffer()
+	defer putTrigramBuffer(buf)
+	for _, example := range examples {
+		script := DetectScript(example)
+		profiles, ok := scriptProfileKeys[script]
+		if !ok {
+			continue
+		}
+		dict := scriptDictionaries[script]
+
+		//Pruning gives the same two closest languages as ranking them all.
+		words := strings.Fields(example)
+		for _, n := range []int{1, 2, 3, 5, 8, len(words)} {
+			if n > len(words) {
+				continue
+			}
+			text := strings.Join(words[:n], " ")
+			trigrams := buf.rankTrigrams(text)
+			for _, options := range optionSets {
+				want := rankText(text, trigrams, options, profiles, dict)
+				if len(want) > 2 {
+					want = want[:2]
+				}
+				got, err := closestLangs(context.Background(), text, trigrams, options, profiles, dict)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, want) {
+					t.Fatalf("%q %v: want %v got %v", text, options, want, got)
+				}
+			}
+		}
+	}
+}
+
+// cancelAfter is a context that is done once Err has been called n times.
+type cancelAfter struct {
+	context.Context
+	n int
+}
+
+func (ctx *cancelAfter) Err() error {
+	if ctx.n == 0 {
+		return context.Canceled
+	}
+	ctx.n--
+	return nil
+}
+
+func TestDetectContext(t *testing.T) {
+	texts := []string{
+		"Je ne sais pas ce que je vais faire demain.",
+		"Мы не знаем, что будет завтра.",
+		"我爱你",
+		"",
+	}
+	for _, text := range texts {
+		info, err := DetectContext(context.Background(), text, Options{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := Detect(text); info != want {
+			t.Fatalf("%q: want %v got %v", text, want, info)
 		}
 	}
+
+	//Cancellation is checked after script detection and before every profile.
+	text := texts[0]
+	for _, options := range []Options{{}, {Model: NewProfileNaiveBayesModel()}, {ScriptFallback: true, Whitelist: map[Lang]bool{Fra: true}}} {
+		checks := 0
+		for {
+			ctx := &cancelAfter{Context: context.Background(), n: checks}
+			info, err := DetectContext(ctx, text, options)
+			if err == nil {
+				if want := DetectWithOptions(text, options); info != want {
+					t.Fatalf("%v: want %v got %v", options, want, info)
+				}
+				break
+			}
+			if err != context.Canceled || info.Lang != Und || info.Reason != ReasonCanceled {
+				t.Fatalf("%v, %d checks: want %v with an undetermined language got %v and %v", options, checks, context.Canceled, err, info)
+			}
+			checks++
+		}
+		if options.Model == nil && len(options.Whitelist) == 0 && checks < len(latinLangs) {
+			t.Fatalf("want a check for each of the %d profiles got %d checks", len(latinLangs), checks)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if lang, err := NewDetector(Options{}).DetectContext(ctx, text); err != context.Canceled || lang.Lang != Und || lang.Reason != ReasonCanceled {
+		t.Fatalf("want %v got %v and %v", context.Canceled, lang, err)
+	}
+	if lang, err := DetectLangContext(ctx, text, Options{}); err != context.Canceled || lang != Und {
+		t.Fatalf("want %v got %v and %v", context.Canceled, LangToString(lang), err)
+	}
 }
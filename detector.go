@@ -0,0 +1,59 @@
+package whatlanggo
+
+import "context"
+
+// Detector detects languages with the same options for every text, e.g. the whitelist
+// and the reliability thresholds of a product surface.
+type Detector struct {
+	options Options
+}
+
+// NewDetector returns a Detector that uses options.
+func NewDetector(options Options) Detector {
+	return Detector{options: options}
+}
+
+// Options returns the options of the detector.
+func (detector Detector) Options() Options {
+	return detector.options
+}
+
+// Detect detects the language and script of the given text.
+func (detector Detector) Detect(text string) Info {
+	return DetectWithOptions(text, detector.options)
+}
+
+// DetectLang detects only the language of the given text.
+func (detector Detector) DetectLang(text string) Lang {
+	return detector.Detect(text).Lang
+}
+
+// IsReliable reports whether info is reliable according to the reliability thresholds
+// of the detector, like Options.IsReliable.
+func (detector Detector) IsReliable(info Info) bool {
+	return detector.options.IsReliable(info)
+}
+
+// DetectContext detects the language and script of the given text unless ctx is done
+// first, like DetectContext.
+func (detector Detector) DetectContext(ctx context.Context, text string) (Info, error) {
+	return DetectContext(ctx, text, detector.options)
+}
+
+// DetectBatch detects the languages of texts concurrently, like DetectBatch.
+func (detector Detector) DetectBatch(ctx context.Context, texts []string) ([]Info, error) {
+	return DetectBatch(ctx, texts, detector.options)
+}
+
+// DetectStream detects the languages of the texts received from texts concurrently,
+// like DetectStream.
+func (detector Detector) DetectStream(ctx context.Context, texts <-chan string) <-chan Info {
+	return DetectStream(ctx, texts, detector.options)
+}
+
+// WithModel returns a copy of the detector that scores languages with model, for
+// instance to compare models on the same traffic.
+func (detector Detector) WithModel(model Model) Detector {
+	detector.options.Model = model
+	return detector
+}
@@ -0,0 +1,28 @@
+package whatlanggo
+
+import "testing"
+
+func TestDetector(t *testing.T) {
+	options := Options{
+		Whitelist:            map[Lang]bool{Epo: true, Ukr: true},
+		ReliabilityThreshold: 0.5,
+	}
+	detector := NewDetector(options)
+
+	if len(detector.Options().Whitelist) != 2 {
+		t.Fatalf("got options %v", detector.Options())
+	}
+
+	text := "Mi ne scias!"
+	want := DetectWithOptions(text, options)
+	got := detector.Detect(text)
+	if got != want || got.Lang != Epo {
+		t.Fatalf("want %v got %v", want, got)
+	}
+	if lang := detector.DetectLang(text); lang != Epo {
+		t.Fatalf("want %v got %v", LangToString(Epo), LangToString(lang))
+	}
+	if detector.IsReliable(got) != options.IsReliable(got) {
+		t.Fatalf("want reliable %t", options.IsReliable(got))
+	}
+}
@@ -0,0 +1,137 @@
+package whatlanggo
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// frequentWordDistance is the distance removed from the trigram distance of a
+	// language for a word of the text that is its most frequent word. Less frequent words
+	// remove less, down to half of it for the last word of the list.
+	frequentWordDistance = 2 * maxTrigramDistance
+
+	// markerWordDistance is the distance removed from the trigram distance of a language
+	// for every marker word of the language found in the text, on top of
+	// frequentWordDistance.
+	markerWordDistance = 2 * maxTrigramDistance
+)
+
+// maxDictionaryWords is the number of words of a text the word distance of a language
+// is computed from. The distance of a longer text is scaled down to as many words, so
+// that the words of a language never remove more than maxWordDistance, and the
+// trigrams of long texts outweigh them.
+const maxDictionaryWords = 16
+
+// maxWordDistance is the largest distance the words of a text remove from the trigram
+// distance of a language. It is far below maxTotalDistance.
+const maxWordDistance = maxDictionaryWords * (frequentWordDistance + markerWordDistance)
+
+// dictionary holds the frequent words and the marker words of the languages written in
+// a script.
+type dictionary struct {
+	// words maps the frequent words and the marker words of every language to the
+	// languages that use them, with the distance they remove from these languages.
+	words map[string][]langDistance
+	// markers maps marker words to the only language that uses them.
+	markers map[string]Lang
+}
+
+// wordMatch is the distance removed from the trigram distance of a language by the
+// words of a text, and the number of these words.
+type wordMatch struct {
+	dist    int
+	matched int
+}
+
+// wordMatches maps languages to the words of a text found in their dictionary.
+type wordMatches map[Lang]wordMatch
+
+// distance returns the distance removed from the trigram distance of lang by its words.
+func (matches wordMatches) distance(lang Lang) int {
+	return matches[lang].dist
+}
+
+// scriptDictionaries holds the dictionaries of the scripts of scriptWords.
+var scriptDictionaries = func() map[*unicode.RangeTable]*dictionary {
+	dicts := make(map[*unicode.RangeTable]*dictionary, len(scriptWords))
+	for script, wordList := range scriptWords {
+		dict := &dictionary{
+			words:   map[string][]langDistance{},
+			markers: map[string]Lang{},
+		}
+		for lang, words := range wordList {
+			for i, word := range words {
+				dict.addWord(word, lang, frequentWordDistance-frequentWordDistance*i/(2*len(words)))
+			}
+		}
+		for lang, words := range scriptMarkers[script] {
+			for _, word := range words {
+				dict.markers[word] = lang
+				dict.addWord(word, lang, markerWordDistance)
+			}
+		}
+		dicts[script] = dict
+	}
+	return dicts
+}()
+
+// addWord adds dist to the distance word removes from lang.
+func (dict *dictionary) addWord(word string, lang Lang, dist int) {
+	for i, langDist := range dict.words[word] {
+		if langDist.lang == lang {
+			dict.words[word][i].dist += dist
+			return
+		}
+	}
+	dict.words[word] = append(dict.words[word], langDistance{lang, dist})
+}
+
+// textWords splits text into lowercase words, the way countNgrams splits it into n-grams.
+func textWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), isStopChar)
+}
+
+// match returns the distance removed from the trigram distance of every language by
+// its frequent words and marker words found in words, and the number of these words.
+// The distance is scaled down to maxDictionaryWords words.
+func (dict *dictionary) match(words []string) wordMatches {
+	if dict == nil {
+		return nil
+	}
+
+	matches := wordMatches{}
+	for _, word := range words {
+		for _, langDist := range dict.words[word] {
+			match := matches[langDist.lang]
+			match.dist += langDist.dist
+			match.matched++
+			matches[langDist.lang] = match
+		}
+	}
+	if len(words) > maxDictionaryWords {
+		for lang, match := range matches {
+			match.dist = match.dist * maxDictionaryWords / len(words)
+			matches[lang] = match
+		}
+	}
+	return matches
+}
+
+// rankText ranks the allowed languages of langProfileList like rankLangs, and brings
+// closer the languages whose words are found in text. Short texts carry too few
+// trigrams to tell languages apart, and closely related languages are often told apart
+// by a few marker words that trigram ranks blur together.
+func rankText(text string, trigrams map[uint64]int, options Options, langProfileList langKeyProfileList, dict *dictionary) []langDistance {
+	langDistances := rankLangs(trigrams, options, langProfileList)
+	if dict == nil {
+		return langDistances
+	}
+
+	matches := dict.match(textWords(text))
+	for i, langDist := range langDistances {
+		langDistances[i].dist -= matches.distance(langDist.lang)
+	}
+	sortLangDistances(langDistances)
+	return langDistances
+}
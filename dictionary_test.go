@@ -0,0 +1,89 @@
+package whatlanggo
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestDetectMarkerWords(t *testing.T) {
+	tests := map[string]Lang{
+		"Eg veit ikkje kva eg skal gjere i morgon, men det blir nok fint.":   Nno,
+		"Jeg vet ikke hva jeg skal gjøre i morgen, men det blir nok fint.":   Nob,
+		"Jeg ved ikke hvad jeg skal gøre i morgen, men det bliver nok fint.": Dan,
+		"Jag vet inte vad jag ska göra i morgon, men det blir nog bra.":      Swe,
+		"Ek weet nie wat ek môre gaan doen nie, maar dit sal goed wees.":     Afr,
+		"Ik weet niet wat ik morgen ga doen, maar het komt wel goed.":        Nld,
+		"Ne znam što ću sutra raditi, ali bit će dobro.":                     Hrv,
+		"Не знам какво ще правя утре, но ще бъде добре.":                     Bul,
+		"Не знам што ќе правам утре, но ќе биде добро.":                      Mkd,
+	}
+
+	for text, want := range tests {
+		if got := DetectLang(text); got != want {
+			t.Fatalf("%q: want %v got %v", text, LangToString(want), LangToString(got))
+		}
+	}
+}
+
+func TestDictionaryMatch(t *testing.T) {
+	dict := scriptDictionaries[unicode.Latin]
+	matches := dict.match(textWords("Ikkje, og du?"))
+
+	//"og" is frequent in Nynorsk, and "ikkje" is a marker of Nynorsk.
+	if match := matches[Nno]; match.matched != 2 || match.dist <= markerWordDistance {
+		t.Fatalf("want 2 words got %d with a distance of %d", match.matched, match.dist)
+	}
+
+	//"og" is frequent in Bokmål as well, but "ikkje" is not a marker of Bokmål.
+	if match := matches[Nob]; match.matched != 1 || match.dist > frequentWordDistance {
+		t.Fatalf("want 1 word got %d with a distance of %d", match.matched, match.dist)
+	}
+
+	var none *dictionary
+	if matches := none.match(textWords("Ikkje, og du?")); matches.distance(Nno) != 0 || matches[Nno].matched != 0 {
+		t.Fatalf("want no distance for a script without dictionary got %v", matches)
+	}
+}
+
+func TestDictionaryMatchLongText(t *testing.T) {
+	//The words of a long text are all frequent and marker words of Nynorsk, but remove
+	//no more than maxWordDistance.
+	words := textWords(strings.Repeat("ikkje og eg ", 1000))
+	match := scriptDictionaries[unicode.Latin].match(words)[Nno]
+	if match.matched != len(words) {
+		t.Fatalf("want %d words got %d", len(words), match.matched)
+	}
+	if match.dist <= 0 || match.dist > maxWordDistance {
+		t.Fatalf("want a distance up to %d got %d", maxWordDistance, match.dist)
+	}
+	if maxWordDistance >= maxTotalDistance/2 {
+		t.Fatalf("maxWordDistance %d is not far below maxTotalDistance %d", maxWordDistance, maxTotalDistance)
+	}
+}
+
+func TestScriptMarkers(t *testing.T) {
+	for script, markers := range scriptMarkers {
+		for lang, words := range markers {
+			if _, ok := scriptProfiles[script][lang]; !ok {
+				t.Fatalf("%v has markers but no profile in %s", LangToString(lang), ScriptCode(script))
+			}
+
+			for _, word := range words {
+				if words := textWords(word); len(words) != 1 || words[0] != word {
+					t.Fatalf("%v: %q is not a lowercase word", LangToString(lang), word)
+				}
+				if marker := scriptDictionaries[script].markers[word]; marker != lang {
+					t.Fatalf("%v: %q is a marker of %v as well", LangToString(lang), word, LangToString(marker))
+				}
+				for other, otherWords := range scriptWords[script] {
+					for _, otherWord := range otherWords {
+						if other != lang && otherWord == word {
+							t.Fatalf("%v: %q is a frequent word of %v as well", LangToString(lang), word, LangToString(other))
+						}
+					}
+				}
+			}
+		}
+	}
+}
@@ -1,4 +1,10 @@
 //Package whatlanggo detects natural languages and scripts ( writing systems ).
 //Languages are represented by a determined list of constants while scripts are
 //represented by *unicode.RangeTable.
+//
+//All the functions of the package are safe for concurrent use. The package-level
+//maps, such as Langs, Scripts and the language profiles, are only read once the
+//package is initialized, and must not be modified. Models returned by the package
+//are not modified by Score either. DetectBatch and DetectStream detect many texts
+//concurrently.
 package whatlanggo
@@ -0,0 +1,167 @@
+package whatlanggo
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// MarshalText implements encoding.TextMarshaler. Lang is encoded as its ISO 639-3 code,
+// which, unlike its numeric value, does not change when languages are added.
+func (lang Lang) MarshalText() ([]byte, error) {
+	if !lang.IsValid() && lang != Und {
+		return nil, fmt.Errorf("whatlanggo: invalid language %d", int(lang))
+	}
+	return []byte(lang.Iso6393()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts the ISO 639-3 codes
+// returned by MarshalText.
+func (lang *Lang) UnmarshalText(text []byte) error {
+	code := string(text)
+	l := CodeToLang(code)
+	if l == Und && code != "und" {
+		return fmt.Errorf("whatlanggo: unknown ISO 639-3 code %q", code)
+	}
+	*lang = l
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Lang is encoded as a JSON string holding
+// its ISO 639-3 code.
+func (lang Lang) MarshalJSON() ([]byte, error) {
+	text, err := lang.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(strconv.Quote(string(text))), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler. JSON null leaves lang unchanged.
+func (lang *Lang) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var code string
+	if err := json.Unmarshal(data, &code); err != nil {
+		return fmt.Errorf("whatlanggo: language must be a JSON string: %v", err)
+	}
+	return lang.UnmarshalText([]byte(code))
+}
+
+// Value implements driver.Valuer. Lang is stored as its ISO 639-3 code.
+func (lang Lang) Value() (driver.Value, error) {
+	text, err := lang.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return string(text), nil
+}
+
+// Scan implements sql.Scanner. It accepts ISO 639-3 codes stored as strings or bytes,
+// NULL is scanned as Und.
+func (lang *Lang) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*lang = Und
+		return nil
+	case string:
+		return lang.UnmarshalText([]byte(v))
+	case []byte:
+		return lang.UnmarshalText(v)
+	default:
+		return fmt.Errorf("whatlanggo: cannot scan %T into Lang", src)
+	}
+}
+
+var reasonCodes = map[Reason]string{
+	ReasonNone:              "none",
+	ReasonEmptyText:         "empty_text",
+	ReasonNoLetters:         "no_letters",
+	ReasonUnsupportedScript: "unsupported_script",
+	ReasonFiltered:          "filtered",
+	ReasonBelowThreshold:    "below_threshold",
+	ReasonCanceled:          "canceled",
+	ReasonScriptMismatch:    "script_mismatch",
+}
+
+// MarshalText implements encoding.TextMarshaler. Reason is encoded as a snake_case
+// identifier such as "empty_text".
+func (reason Reason) MarshalText() ([]byte, error) {
+	code, ok := reasonCodes[reason]
+	if !ok {
+		return nil, fmt.Errorf("whatlanggo: invalid reason %d", int(reason))
+	}
+	return []byte(code), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (reason *Reason) UnmarshalText(text []byte) error {
+	for r, code := range reasonCodes {
+		if code == string(text) {
+			*reason = r
+			return nil
+		}
+	}
+	return fmt.Errorf("whatlanggo: unknown reason %q", string(text))
+}
+
+// infoJSON is the JSON form of Info.
+type infoJSON struct {
+	Lang       Lang    `json:"lang"`
+	Script     string  `json:"script,omitempty"`
+	Confidence float64 `json:"confidence"`
+	Reason     Reason  `json:"reason,omitempty"`
+
+	AnalyzedRunes int    `json:"analyzed_runes,omitempty"`
+	Sampled       bool   `json:"sampled,omitempty"`
+	HanVariant    string `json:"han_variant,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. Info is encoded as a JSON object holding
+// the ISO 639-3 code of the language, the ISO 15924 code of the script, the confidence,
+// the number of runes analyzed and whether they were sampled, the Han variant used by
+// Tag for Chinese and, when the language is undetermined, the reason, e.g.
+// {"lang":"eng","script":"Latn","confidence":1,"analyzed_runes":42}.
+func (info Info) MarshalJSON() ([]byte, error) {
+	return json.Marshal(infoJSON{
+		Lang:          info.Lang,
+		Script:        ScriptCode(info.Script),
+		Confidence:    info.Confidence,
+		Reason:        info.Reason,
+		AnalyzedRunes: info.AnalyzedRunes,
+		Sampled:       info.Sampled,
+		HanVariant:    info.HanVariant,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (info *Info) UnmarshalJSON(data []byte) error {
+	v := infoJSON{Lang: Und}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	script := CodeToScript(v.Script)
+	if script == nil && v.Script != "" {
+		return fmt.Errorf("whatlanggo: unknown ISO 15924 code %q", v.Script)
+	}
+
+	switch v.HanVariant {
+	case "", HanVariantSimplified, HanVariantTraditional:
+	default:
+		return fmt.Errorf("whatlanggo: unknown Han variant %q", v.HanVariant)
+	}
+
+	*info = Info{
+		Lang:          v.Lang,
+		Script:        script,
+		Confidence:    v.Confidence,
+		Reason:        v.Reason,
+		AnalyzedRunes: v.AnalyzedRunes,
+		Sampled:       v.Sampled,
+		HanVariant:    v.HanVariant,
+	}
+	return nil
+}
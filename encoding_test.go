@@ -0,0 +1,152 @@
+package whatlanggo
+
+import (
+	"encoding/json"
+	"testing"
+	"unicode"
+)
+
+func TestLangText(t *testing.T) {
+	for lang := range Langs {
+		text, err := lang.MarshalText()
+		if err != nil {
+			t.Fatalf("%s: %v", LangToString(lang), err)
+		}
+		var got Lang
+		if err := got.UnmarshalText(text); err != nil {
+			t.Fatalf("%s: %v", text, err)
+		}
+		if got != lang {
+			t.Fatalf("%s: want %d got %d", text, lang, got)
+		}
+	}
+
+	text, err := Und.MarshalText()
+	if err != nil || string(text) != "und" {
+		t.Fatalf("want und got %s %v", text, err)
+	}
+	if _, err := Lang(1000).MarshalText(); err == nil {
+		t.Fatal("want error for invalid language")
+	}
+
+	var lang Lang
+	for _, code := range []string{"", "xxx", "en", "ENG"} {
+		if err := lang.UnmarshalText([]byte(code)); err == nil {
+			t.Fatalf("%q: want error", code)
+		}
+	}
+}
+
+func TestLangJSON(t *testing.T) {
+	data, err := json.Marshal(map[string]interface{}{"lang": Deu, "langs": []Lang{Eng, Und}, "weights": map[Lang]int{Fra: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"lang":"deu","langs":["eng","und"],"weights":{"fra":1}}`
+	if string(data) != want {
+		t.Fatalf("want %s got %s", want, data)
+	}
+
+	var v struct {
+		Lang    Lang
+		Langs   []Lang
+		Weights map[Lang]int
+	}
+	if err := json.Unmarshal([]byte(`{"lang":"deu","langs":["eng","und"],"weights":{"fra":1}}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Lang != Deu || len(v.Langs) != 2 || v.Langs[0] != Eng || v.Langs[1] != Und || v.Weights[Fra] != 1 {
+		t.Fatalf("got %v", v)
+	}
+
+	for _, data := range []string{`"xxx"`, `13`} {
+		var lang Lang
+		if err := json.Unmarshal([]byte(data), &lang); err == nil {
+			t.Fatalf("%s: want error", data)
+		}
+	}
+}
+
+func TestLangSQL(t *testing.T) {
+	value, err := Nob.Value()
+	if err != nil || value != "nob" {
+		t.Fatalf("want nob got %v %v", value, err)
+	}
+
+	tests := map[interface{}]Lang{
+		"nob": Nob,
+		"und": Und,
+		nil:   Und,
+	}
+	for src, want := range tests {
+		var got Lang
+		if err := got.Scan(src); err != nil {
+			t.Fatalf("%v: %v", src, err)
+		}
+		if got != want {
+			t.Fatalf("%v: want %d got %d", src, want, got)
+		}
+	}
+
+	var got Lang
+	if err := got.Scan([]byte("ukr")); err != nil || got != Ukr {
+		t.Fatalf("want %d got %d %v", Ukr, got, err)
+	}
+	if err := got.Scan(int64(15)); err == nil {
+		t.Fatal("want error scanning an integer")
+	}
+}
+
+func TestInfoJSON(t *testing.T) {
+	tests := map[string]Info{
+		`{"lang":"eng","script":"Latn","confidence":0.5}`:                                    {Lang: Eng, Script: unicode.Latin, Confidence: 0.5},
+		`{"lang":"jpn","script":"Hrkt","confidence":1}`:                                      {Lang: Jpn, Script: _HiraganaKatakana, Confidence: 1},
+		`{"lang":"und","script":"Hebr","confidence":0,"reason":"filtered"}`:                  {Lang: Und, Script: unicode.Hebrew, Reason: ReasonFiltered},
+		`{"lang":"und","confidence":0,"reason":"empty_text"}`:                                {Lang: Und, Reason: ReasonEmptyText},
+		`{"lang":"und","confidence":0,"reason":"canceled"}`:                                  {Lang: Und, Reason: ReasonCanceled},
+		`{"lang":"cmn","script":"Hani","confidence":1,"han_variant":"Hant"}`:                 {Lang: Cmn, Script: unicode.Han, Confidence: 1, HanVariant: HanVariantTraditional},
+		`{"lang":"fra","script":"Latn","confidence":1,"analyzed_runes":1000,"sampled":true}`: {Lang: Fra, Script: unicode.Latin, Confidence: 1, AnalyzedRunes: 1000, Sampled: true},
+	}
+
+	for want, info := range tests {
+		data, err := json.Marshal(info)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != want {
+			t.Fatalf("want %s got %s", want, data)
+		}
+
+		var got Info
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != info {
+			t.Fatalf("%s: want %v got %v", data, info, got)
+		}
+	}
+
+	var info Info
+	if err := json.Unmarshal([]byte(`{"lang":"eng","script":"Xxxx"}`), &info); err == nil {
+		t.Fatal("want error for unknown script")
+	}
+	if err := json.Unmarshal([]byte(`{"lang":"cmn","script":"Hani","han_variant":"Xxxx"}`), &info); err == nil {
+		t.Fatal("want error for unknown Han variant")
+	}
+
+	//The tags of Chinese texts are kept.
+	for _, text := range []string{"我们的国家", "我們的國家"} {
+		want := Detect(text)
+		data, err := json.Marshal(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Info
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != want || got.Tag() != want.Tag() {
+			t.Fatalf("%s: want %v (%s) got %v (%s)", data, want, want.Tag(), got, got.Tag())
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package whatlanggo
+
+import (
+	"errors"
+	"math"
+)
+
+// WeightedModel is a model of an Ensemble with the weight of its scores and votes.
+type WeightedModel struct {
+	// Model is the model. nil means TrigramModel.
+	Model Model
+	// Weight is the weight of the model. Models with a weight that is not positive
+	// are left out.
+	Weight float64
+}
+
+// Ensemble combines several models. It is a Model itself, whose score for a language
+// is the weighted average of the log-probabilities of the language according to every
+// model, and it is used like any other model through Options.Model or
+// Detector.WithModel. The features of the text are extracted once for all the models.
+// The confidence of a detection is scaled by the weighted share of the models whose
+// best language is the detected one, so that the models have to agree for the
+// detection to be reliable.
+type Ensemble struct {
+	models []WeightedModel
+	weight float64
+}
+
+// NewEnsemble returns an Ensemble of models. It returns an error when no model has a
+// positive weight.
+func NewEnsemble(models ...WeightedModel) (*Ensemble, error) {
+	ensemble := &Ensemble{}
+	for _, model := range models {
+		if model.Weight <= 0 {
+			continue
+		}
+		if model.Model == nil {
+			model.Model = TrigramModel{}
+		}
+		ensemble.models = append(ensemble.models, model)
+		ensemble.weight += model.Weight
+	}
+	if len(ensemble.models) == 0 {
+		return nil, errors.New("whatlanggo: no model of the ensemble has a positive weight")
+	}
+	return ensemble, nil
+}
+
+// Detect detects the language and script of text with the ensemble and options.
+func (ensemble *Ensemble) Detect(text string, options Options) Info {
+	options.Model = ensemble
+	return DetectWithOptions(text, options)
+}
+
+// Score returns the weighted average of the log-probabilities of every candidate
+// language according to the models of the ensemble.
+func (ensemble *Ensemble) Score(features Features, candidates []Lang) []LangScore {
+	scores, _ := ensemble.score(features, candidates)
+	return scores
+}
+
+// score returns the scores of the candidate languages, and the weighted share of the
+// models that vote for every language, that is the models whose best language it is.
+// Models that score no candidate are left out. Candidates a model does not score get
+// the lowest log-probability the model gives.
+func (ensemble *Ensemble) score(features Features, candidates []Lang) ([]LangScore, map[Lang]float64) {
+	index := make(map[Lang]int, len(candidates))
+	scores := make([]LangScore, len(candidates))
+	for i, lang := range candidates {
+		index[lang] = i
+		scores[i].Lang = lang
+	}
+	// The zero Ensemble, which NewEnsemble does not return, scores like TrigramModel.
+	models, weight := ensemble.models, ensemble.weight
+	if len(models) == 0 {
+		models, weight = []WeightedModel{{TrigramModel{}, 1}}, 1
+	}
+	votes := make(map[Lang]float64, len(models))
+
+	for _, model := range models {
+		modelScores := candidateScores(model.Model.Score(features, candidates), index)
+		if len(modelScores) == 0 {
+			continue
+		}
+		share := model.Weight / weight
+		best := 0
+		for i, score := range modelScores {
+			if score.Score > modelScores[best].Score || (score.Score == modelScores[best].Score && score.Lang < modelScores[best].Lang) {
+				best = i
+			}
+		}
+		votes[modelScores[best].Lang] += share
+
+		logSum := logSumExp(modelScores)
+		lowest := math.Inf(1)
+		scored := make([]bool, len(candidates))
+		for _, score := range modelScores {
+			logProb := score.Score - logSum
+			scores[index[score.Lang]].Score += share * logProb
+			scored[index[score.Lang]] = true
+			lowest = math.Min(lowest, logProb)
+		}
+		for i := range scores {
+			if !scored[i] {
+				scores[i].Score += share * lowest
+			}
+		}
+	}
+	return scores, votes
+}
+
+// candidateScores returns the scores of a model that are finite scores of candidate
+// languages given by index, keeping the first score of every language.
+func candidateScores(modelScores []LangScore, index map[Lang]int) []LangScore {
+	scored := make(map[Lang]bool, len(modelScores))
+	valid := make([]LangScore, 0, len(modelScores))
+	for _, score := range modelScores {
+		if _, ok := index[score.Lang]; !ok || scored[score.Lang] || math.IsNaN(score.Score) || math.IsInf(score.Score, 0) {
+			continue
+		}
+		scored[score.Lang] = true
+		valid = append(valid, score)
+	}
+	return valid
+}
+
+// logSumExp returns the logarithm of the sum of the exponentials of scores, which are
+// not empty.
+func logSumExp(scores []LangScore) float64 {
+	max := math.Inf(-1)
+	for _, score := range scores {
+		max = math.Max(max, score.Score)
+	}
+	sum := 0.0
+	for _, score := range scores {
+		sum += math.Exp(score.Score - max)
+	}
+	return max + math.Log(sum)
+}
@@ -0,0 +1,122 @@
+package whatlanggo
+
+import (
+	"math"
+	"testing"
+	"unicode"
+)
+
+func newEnsemble(t *testing.T, models ...WeightedModel) *Ensemble {
+	ensemble, err := NewEnsemble(models...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ensemble
+}
+
+func TestEnsemble(t *testing.T) {
+	ensemble := newEnsemble(t,
+		WeightedModel{Weight: 2},
+		WeightedModel{Model: NewProfileNaiveBayesModel(), Weight: 1},
+	)
+	tests := map[string]Lang{
+		"Je ne sais pas ce que je vais faire demain.":           Fra,
+		"Ich weiß nicht, was ich morgen machen werde.":          Deu,
+		"Мы не знаем, что будет завтра, но надеемся на лучшее.": Rus,
+	}
+	for text, want := range tests {
+		info := ensemble.Detect(text, Options{})
+		if info.Lang != want {
+			t.Fatalf("%s: want %v got %v", text, LangToString(want), LangToString(info.Lang))
+		}
+		if got := DetectWithOptions(text, Options{Model: ensemble}); got != info {
+			t.Fatalf("%s: want %v got %v", text, info, got)
+		}
+	}
+}
+
+func TestEnsembleAgreement(t *testing.T) {
+	text := "Where there is a will there is a way"
+	options := Options{ShortTextTrigrams: -1}
+	fra, deu := constantModel{Fra: 2, Deu: 1}, constantModel{Fra: 1, Deu: 2}
+
+	//Models that agree give the confidence of a single model.
+	info := newEnsemble(t, WeightedModel{fra, 1}, WeightedModel{fra, 1}).Detect(text, options)
+	if info.Lang != Fra || math.Abs(info.Confidence-(1-math.Exp(-1))) > 1e-9 {
+		t.Fatalf("want %v with a confidence of 1-1/e got %v", LangToString(Fra), info)
+	}
+
+	//The heavier model wins, and the confidence is scaled by its share of the votes.
+	info = newEnsemble(t, WeightedModel{fra, 3}, WeightedModel{deu, 1}).Detect(text, options)
+	if info.Lang != Fra {
+		t.Fatalf("want %v got %v", LangToString(Fra), LangToString(info.Lang))
+	}
+	if want := 0.75 * (1 - math.Exp(-0.5)); math.Abs(info.Confidence-want) > 1e-9 {
+		t.Fatalf("want a confidence of %v got %v", want, info.Confidence)
+	}
+
+	//Models with a weight that is not positive are left out.
+	info = newEnsemble(t, WeightedModel{fra, 1}, WeightedModel{deu, 0}).Detect(text, options)
+	if info.Lang != Fra || math.Abs(info.Confidence-(1-math.Exp(-1))) > 1e-9 {
+		t.Fatalf("want %v with a confidence of 1-1/e got %v", LangToString(Fra), info)
+	}
+}
+
+func TestEnsembleWithoutModels(t *testing.T) {
+	if _, err := NewEnsemble(); err == nil {
+		t.Fatal("want an error for an ensemble without models")
+	}
+	if _, err := NewEnsemble(WeightedModel{Weight: 0}, WeightedModel{Model: constantModel{}, Weight: -1}); err == nil {
+		t.Fatal("want an error for an ensemble without a positive weight")
+	}
+
+	//The zero Ensemble scores like the trigram model.
+	text := "Where there is a will there is a way"
+	if got := (&Ensemble{}).Detect(text, Options{}); got.Lang != Eng || !got.IsReliable() {
+		t.Fatalf("want a reliable %v got %v", LangToString(Eng), got)
+	}
+
+	//Equal scores give a confidence of 0, not -0.
+	info := newEnsemble(t, WeightedModel{constantModel{}, 1}).Detect(text, Options{ShortTextTrigrams: -1})
+	if info.Confidence != 0 || math.Signbit(info.Confidence) {
+		t.Fatalf("want a confidence of 0 got %v", info.Confidence)
+	}
+}
+
+type scoreFunc func(features Features, candidates []Lang) []LangScore
+
+func (score scoreFunc) Score(features Features, candidates []Lang) []LangScore {
+	return score(features, candidates)
+}
+
+func TestEnsembleInvalidScores(t *testing.T) {
+	text := "Where there is a will there is a way"
+	options := Options{ShortTextTrigrams: -1}
+	fra := constantModel{Fra: 2, Deu: 1}
+	empty := scoreFunc(func(Features, []Lang) []LangScore { return nil })
+
+	//Models that score no candidate are left out of the votes.
+	info := newEnsemble(t, WeightedModel{fra, 1}, WeightedModel{empty, 1}).Detect(text, options)
+	if want := 0.5 * (1 - math.Exp(-0.5)); info.Lang != Fra || math.Abs(info.Confidence-want) > 1e-9 {
+		t.Fatalf("want %v with a confidence of %v got %v", LangToString(Fra), want, info)
+	}
+
+	//Scores of languages that are not candidates are ignored.
+	foreign := scoreFunc(func(Features, []Lang) []LangScore {
+		return []LangScore{{Jpn, 100}, {Fra, 1}, {Deu, 0}, {Fra, -100}}
+	})
+	scores := newEnsemble(t, WeightedModel{foreign, 1}).Score(newFeatures(text, unicode.Latin, Options{}), []Lang{Deu, Fra})
+	want := []LangScore{{Deu, -math.Log(1 + math.E)}, {Fra, 1 - math.Log(1+math.E)}}
+	for i := range want {
+		if scores[i].Lang != want[i].Lang || math.Abs(scores[i].Score-want[i].Score) > 1e-9 {
+			t.Fatalf("want %v got %v", want, scores)
+		}
+	}
+
+	//Candidates a model does not score get its lowest log-probability.
+	partial := scoreFunc(func(Features, []Lang) []LangScore { return []LangScore{{Fra, 1}, {Deu, 0}} })
+	scores = newEnsemble(t, WeightedModel{partial, 1}).Score(newFeatures(text, unicode.Latin, Options{}), []Lang{Deu, Eng, Fra})
+	if scores[1].Lang != Eng || scores[1].Score != scores[0].Score {
+		t.Fatalf("want %v scored like %v got %v", LangToString(Eng), LangToString(Deu), scores)
+	}
+}
@@ -0,0 +1,117 @@
+package whatlanggo
+
+// Explanation describes how the language of a text was chosen by the trigram model.
+type Explanation struct {
+	// Info is the result of the detection, as returned by DetectWithOptions.
+	Info Info `json:"info"`
+	// Trigrams are the trigrams of the text, from the most to the least frequent one.
+	// The index of a trigram is its rank in the text.
+	Trigrams []string `json:"trigrams"`
+	// Candidates are the winning language and the runner-up, closest first. There are
+	// fewer candidates when the script of the text is used by a single language or
+	// when the other languages are filtered out by the options.
+	Candidates []LangExplanation `json:"candidates"`
+}
+
+// LangExplanation describes the distance between a text and the profile of a language.
+type LangExplanation struct {
+	Lang Lang `json:"lang"`
+	// Distance is the sum of the distances of the contributions. The lower, the closer.
+	Distance int `json:"distance"`
+	// PriorDistance is the distance added by Options.Priors, which is not part of
+	// Distance. Candidates are ranked by their total distance.
+	PriorDistance int `json:"prior_distance,omitempty"`
+	// WordDistance is the distance removed by the frequent words and the marker words
+	// of the language found in the text, which is not part of Distance either.
+	WordDistance int `json:"word_distance,omitempty"`
+	// Contributions holds one entry for each trigram of the language profile, in the
+	// order of the profile.
+	Contributions []TrigramContribution `json:"contributions"`
+	// Unmatched are the trigrams of the text that are not in the language profile,
+	// from the most to the least frequent one. They do not add to the distance.
+	Unmatched []string `json:"unmatched"`
+}
+
+// TrigramContribution is the distance one trigram of a language profile adds to the
+// distance between a text and the profile.
+type TrigramContribution struct {
+	Trigram string `json:"trigram"`
+	// ProfileRank is the rank of the trigram in the language profile.
+	ProfileRank int `json:"profile_rank"`
+	// TextRank is the rank of the trigram in the text, -1 when the text does not contain it.
+	TextRank int `json:"text_rank"`
+	// Distance is the difference between the ranks, or the maximum distance of a
+	// trigram when the text does not contain it. In short texts, it is half the rank of
+	// the trigram in the profile.
+	Distance int `json:"distance"`
+}
+
+// Missing returns true if the text does not contain the trigram, in which case it
+// is charged the maximum distance.
+func (contribution TrigramContribution) Missing() bool {
+	return contribution.TextRank < 0
+}
+
+// Explain detects the language of text like DetectWithOptions and reports the
+// trigram distances of the winning language and the runner-up. It is meant for
+// debugging wrong detections, and is much slower than detection.
+func Explain(text string, options Options) Explanation {
+	info := DetectWithOptions(text, options)
+	text, _, _ = options.sample(text)
+	trigrams := getTrigramsWithPositions(text)
+
+	explanation := Explanation{
+		Info:     info,
+		Trigrams: make([]string, len(trigrams)),
+	}
+	for trigram, rank := range trigrams {
+		explanation.Trigrams[rank] = trigram
+	}
+
+	profiles := scriptProfiles[info.Script]
+	dict := scriptDictionaries[info.Script]
+	matches := dict.match(textWords(text))
+	short := options.isShortText(len(trigrams))
+	for i, langDist := range rankText(text, packTrigrams(trigrams), options, scriptProfileKeys[info.Script], dict) {
+		if i == 2 {
+			break
+		}
+		candidate := explainDistance(langDist.lang, profiles[langDist.lang], explanation.Trigrams, trigrams, short)
+		candidate.WordDistance = matches.distance(langDist.lang)
+		candidate.PriorDistance = langDist.dist - candidate.Distance + candidate.WordDistance
+		explanation.Candidates = append(explanation.Candidates, candidate)
+	}
+	return explanation
+}
+
+// explainDistance breaks down the distance computed by calculateDistance, or by
+// calculateShortTextDistance for short texts, into the contributions of every trigram
+// of the language profile.
+func explainDistance(lang Lang, langTrigrams []string, textTrigrams []string, positions map[string]int, short bool) LangExplanation {
+	explanation := LangExplanation{
+		Lang:          lang,
+		Contributions: make([]TrigramContribution, len(langTrigrams)),
+	}
+
+	inProfile := make(map[string]bool, len(langTrigrams))
+	for i, trigram := range langTrigrams {
+		contribution := TrigramContribution{Trigram: trigram, ProfileRank: i, TextRank: -1, Distance: maxTrigramDistance}
+		if n, ok := positions[trigram]; ok {
+			contribution.TextRank = n
+			contribution.Distance = abs(n - i)
+			if short {
+				contribution.Distance = i / 2
+			}
+		}
+		explanation.Contributions[i] = contribution
+		explanation.Distance += contribution.Distance
+		inProfile[trigram] = true
+	}
+
+	for _, trigram := range textTrigrams {
+		if !inProfile[trigram] {
+			explanation.Unmatched = append(explanation.Unmatched, trigram)
+		}
+	}
+	return explanation
+}
@@ -0,0 +1,98 @@
+package whatlanggo
+
+import (
+	"encoding/json"
+	"testing"
+	"unicode"
+)
+
+func TestExplain(t *testing.T) {
+	text := "Where there is a will there is a way"
+	options := Options{ShortTextTrigrams: -1}
+	explanation := Explain(text, options)
+
+	if explanation.Info != DetectWithOptions(text, options) {
+		t.Fatalf("want %v got %v", DetectWithOptions(text, options), explanation.Info)
+	}
+	if len(explanation.Candidates) != 2 || explanation.Candidates[0].Lang != Eng {
+		t.Fatalf("got %d candidates", len(explanation.Candidates))
+	}
+
+	trigrams := getTrigramsWithPositions(text)
+	if len(explanation.Trigrams) != len(trigrams) {
+		t.Fatalf("want %d trigrams got %d", len(trigrams), len(explanation.Trigrams))
+	}
+	for rank, trigram := range explanation.Trigrams {
+		if trigrams[trigram] != rank {
+			t.Fatalf("%q: want rank %d got %d", trigram, trigrams[trigram], rank)
+		}
+	}
+
+	for _, candidate := range explanation.Candidates {
+		profile := latinLangs[candidate.Lang]
+		if want := calculateNgramDistance(profile, trigrams, maxTrigramDistance); candidate.Distance != want {
+			t.Fatalf("%s: want distance %d got %d", LangToString(candidate.Lang), want, candidate.Distance)
+		}
+		if len(candidate.Contributions) != len(profile) {
+			t.Fatalf("%s: want %d contributions got %d", LangToString(candidate.Lang), len(profile), len(candidate.Contributions))
+		}
+
+		sum, matched := 0, 0
+		for _, contribution := range candidate.Contributions {
+			sum += contribution.Distance
+			if contribution.Missing() {
+				if contribution.Distance != maxTrigramDistance {
+					t.Fatalf("%q: missing trigram charged %d", contribution.Trigram, contribution.Distance)
+				}
+			} else {
+				matched++
+			}
+		}
+		if sum != candidate.Distance {
+			t.Fatalf("%s: want distance %d got %d", LangToString(candidate.Lang), candidate.Distance, sum)
+		}
+		if matched+len(candidate.Unmatched) != len(trigrams) {
+			t.Fatalf("%s: %d matched and %d unmatched trigrams out of %d", LangToString(candidate.Lang), matched, len(candidate.Unmatched), len(trigrams))
+		}
+	}
+	if explanation.Candidates[0].Distance > explanation.Candidates[1].Distance {
+		t.Fatal("candidates are not sorted")
+	}
+
+	if _, err := json.Marshal(explanation); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExplainWithoutProfiles(t *testing.T) {
+	explanation := Explain("我爱你", Options{})
+	if explanation.Info.Lang != Cmn || explanation.Info.Script != unicode.Han || len(explanation.Candidates) != 0 {
+		t.Fatalf("got %v %d candidates", explanation.Info, len(explanation.Candidates))
+	}
+
+	explanation = Explain("Tu me manques", Options{Whitelist: map[Lang]bool{Fra: true}})
+	if len(explanation.Candidates) != 1 || explanation.Candidates[0].Lang != Fra {
+		t.Fatalf("got %v", explanation.Candidates)
+	}
+
+	explanation = Explain("", Options{})
+	if explanation.Info.Reason != ReasonEmptyText || len(explanation.Trigrams) != 0 || len(explanation.Candidates) != 0 {
+		t.Fatalf("got %v", explanation)
+	}
+}
+
+func TestExplainPriors(t *testing.T) {
+	text := "Como estás tu hoje"
+	explanation := Explain(text, Options{Priors: map[Lang]float64{Por: 10}})
+	if explanation.Info.Lang != Por || len(explanation.Candidates) != 2 {
+		t.Fatalf("want %v with 2 candidates got %v", LangToString(Por), explanation)
+	}
+
+	por, other := explanation.Candidates[0], explanation.Candidates[1]
+	if por.PriorDistance != 0 || other.PriorDistance <= 0 {
+		t.Fatalf("want no prior distance for %v and some for %v got %d and %d", LangToString(Por), LangToString(other.Lang), por.PriorDistance, other.PriorDistance)
+	}
+	if por.Distance+por.PriorDistance > other.Distance+other.PriorDistance {
+		t.Fatalf("candidates are not ranked by total distance: %v", explanation.Candidates)
+	}
+}
@@ -0,0 +1,41 @@
+package whatlanggo
+
+import "strings"
+
+// Frequent characters that differ between Simplified and Traditional Chinese.
+// The n-th character of hanSimplified corresponds to the n-th character of hanTraditional.
+const (
+	hanSimplified  = "这们国说来时为会个对发经过后进动东长学开关见门问间电车马书语话让还边无与应实现样两没从头听买卖爱华岁写体万气钱难风飞鸟鱼龙亲认识请谢读红绿远运农业产种义乐处总当战军师网纪结给线级纸词试该谁变办务热灯图团园场块坏声"
+	hanTraditional = "這們國說來時為會個對發經過後進動東長學開關見門問間電車馬書語話讓還邊無與應實現樣兩沒從頭聽買賣愛華歲寫體萬氣錢難風飛鳥魚龍親認識請謝讀紅綠遠運農業產種義樂處總當戰軍師網紀結給線級紙詞試該誰變辦務熱燈圖團園場塊壞聲"
+)
+
+// Han variants of Info.HanVariant, as ISO 15924 codes.
+const (
+	// HanVariantSimplified is the variant of Chinese written in Simplified characters.
+	HanVariantSimplified = "Hans"
+	// HanVariantTraditional is the variant of Chinese written in Traditional characters.
+	HanVariantTraditional = "Hant"
+)
+
+// detectHanVariant returns "Hans" if text is written in Simplified Chinese characters,
+// "Hant" if it is written in Traditional Chinese characters, and empty string when it
+// cannot be told.
+func detectHanVariant(text string) string {
+	simplified, traditional := 0, 0
+	for _, r := range text {
+		if strings.ContainsRune(hanSimplified, r) {
+			simplified++
+		} else if strings.ContainsRune(hanTraditional, r) {
+			traditional++
+		}
+	}
+
+	switch {
+	case simplified > traditional:
+		return HanVariantSimplified
+	case traditional > simplified:
+		return HanVariantTraditional
+	default:
+		return ""
+	}
+}
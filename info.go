@@ -7,9 +7,65 @@ type Info struct {
 	Lang       Lang
 	Script     *unicode.RangeTable
 	Confidence float64
+	// Reason explains why Lang is Und. It is ReasonNone when a language was detected,
+	// and ReasonScriptMismatch when Options.ScriptFallback chose a language written in
+	// none of the scripts of the text.
+	Reason Reason
+	// AnalyzedRunes is the number of runes of the text that were analyzed.
+	AnalyzedRunes int
+	// Sampled reports whether the text was longer than Options.MaxRunes, so that only
+	// AnalyzedRunes of its runes were analyzed.
+	Sampled bool
+	// HanVariant is HanVariantSimplified or HanVariantTraditional when Chinese text is
+	// written in Simplified or Traditional characters, and empty otherwise. Tag adds it
+	// to the tag of Chinese.
+	HanVariant string
 }
 
-// IsReliable returns true if Confidence is greater than the Reliable Confidence Threshold
+// IsReliable returns true if Confidence is greater than the Reliable Confidence Threshold.
+// Options.IsReliable applies the thresholds of the detection options instead.
 func (info *Info) IsReliable() bool {
 	return info.Confidence > ReliableConfidenceThreshold
 }
+
+// Reason explains why the language of a text could not be determined.
+type Reason int
+
+const (
+	// ReasonNone means that the language was determined.
+	ReasonNone Reason = iota
+	// ReasonEmptyText means that the text is empty.
+	ReasonEmptyText
+	// ReasonNoLetters means that the text contains only spaces, punctuation, digits or symbols.
+	ReasonNoLetters
+	// ReasonUnsupportedScript means that the text is written in a script that is not supported.
+	ReasonUnsupportedScript
+	// ReasonFiltered means that all the languages written in the script of the text
+	// were excluded by the whitelist or the blacklist.
+	ReasonFiltered
+	// ReasonBelowThreshold means that the text does not provide enough evidence
+	// to choose a language.
+	ReasonBelowThreshold
+	// ReasonCanceled means that detection stopped because its context was done.
+	ReasonCanceled
+	// ReasonScriptMismatch means that no allowed language is written in the scripts of
+	// the text, and that Options.ScriptFallback returned an allowed language anyway,
+	// chosen by its prior. Unlike the other reasons, Lang is not Und.
+	ReasonScriptMismatch
+)
+
+var reasonNames = map[Reason]string{
+	ReasonNone:              "none",
+	ReasonEmptyText:         "empty text",
+	ReasonNoLetters:         "no letters",
+	ReasonUnsupportedScript: "unsupported script",
+	ReasonFiltered:          "all candidates filtered",
+	ReasonBelowThreshold:    "below threshold",
+	ReasonCanceled:          "canceled",
+	ReasonScriptMismatch:    "script mismatch",
+}
+
+// String returns a short description of the reason.
+func (reason Reason) String() string {
+	return reasonNames[reason]
+}
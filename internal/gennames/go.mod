@@ -0,0 +1,9 @@
+module github.com/abadojack/whatlanggo/internal/gennames
+
+go 1.23
+
+require github.com/abadojack/whatlanggo v0.0.0
+
+require golang.org/x/text v0.21.0
+
+replace github.com/abadojack/whatlanggo => ../..
@@ -0,0 +1,89 @@
+// Command gennames generates names_data.go, the localized language names returned by
+// Lang.NameIn, from the CLDR data bundled with golang.org/x/text.
+//
+// It lives in its own module so that whatlanggo itself has no external dependency.
+// Run it with go generate from the root of the repository.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+
+	"github.com/abadojack/whatlanggo"
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+)
+
+// displayTags maps languages to the CLDR locale of their names, when it is not their
+// BCP 47 tag.
+var displayTags = map[whatlanggo.Lang]string{
+	whatlanggo.Nob: "no",
+	whatlanggo.Tgl: "fil",
+}
+
+func main() {
+	output := flag.String("o", "names_data.go", "output file")
+	flag.Parse()
+
+	supported := map[string]bool{}
+	for _, tag := range display.Supported.Tags() {
+		supported[tag.String()] = true
+	}
+
+	langs := whatlanggo.SupportedLanguages()
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by internal/gennames from CLDR data; DO NOT EDIT.\n\n")
+	buf.WriteString("package whatlanggo\n\n")
+	buf.WriteString("// localizedNames maps display languages to the names of languages in them.\n")
+	buf.WriteString("// Names missing from CLDR are left out.\n")
+	buf.WriteString("var localizedNames = map[Lang]map[Lang]string{\n")
+
+	for _, displayLang := range langs {
+		// English names are the ones of Langs.
+		if displayLang == whatlanggo.Eng {
+			continue
+		}
+		displayTag, ok := displayTags[displayLang]
+		if !ok {
+			displayTag = whatlanggo.Info{Lang: displayLang}.Tag()
+		}
+		if !supported[displayTag] {
+			log.Printf("skipping display language %s: no CLDR names for %q", displayLang, displayTag)
+			continue
+		}
+		namer := display.Languages(language.Make(displayTag))
+
+		var names bytes.Buffer
+		for _, lang := range langs {
+			if name := namer.Name(language.Make(whatlanggo.Info{Lang: lang}.Tag())); name != "" {
+				fmt.Fprintf(&names, "%s: %q,\n", identifier(lang), name)
+			}
+		}
+		if names.Len() == 0 {
+			log.Printf("skipping display language %s: no names in %q", displayLang, displayTag)
+			continue
+		}
+		fmt.Fprintf(&buf, "%s: {\n%s},\n", identifier(displayLang), names.Bytes())
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// identifier returns the name of the constant of lang, its capitalized ISO 639-3 code.
+func identifier(lang whatlanggo.Lang) string {
+	code := lang.Iso6393()
+	return strings.ToUpper(code[:1]) + code[1:]
+}
@@ -91,6 +91,10 @@ const (
 	Zul
 )
 
+// Und represents an undetermined language, it is returned when the language of a
+// text cannot be detected.
+const Und Lang = -1
+
 // CodeToLang gets enum by ISO 639-3 code as a string.
 func CodeToLang(code string) Lang {
 	lang := map[string]Lang{
@@ -178,13 +182,14 @@ func CodeToLang(code string) Lang {
 		"ydd": Ydd,
 		"yor": Yor,
 		"zul": Zul,
+		"und": Und,
 	}
 
 	if val, ok := lang[code]; ok {
 		return val
 	}
 
-	return -1
+	return Und
 }
 
 // Iso6391 returns ISO 639-1 code of Lang as a string.
@@ -385,6 +390,7 @@ func LangToString(lang Lang) string {
 		Ydd: "ydd",
 		Yor: "yor",
 		Zul: "zul",
+		Und: "und",
 	}
 
 	if val, ok := langMap[lang]; ok {
@@ -399,10 +405,20 @@ func (lang Lang) String() string {
 	if val, ok := Langs[lang]; ok {
 		return val
 	}
+	if lang == Und {
+		return "Undetermined"
+	}
 
 	return ""
 }
 
+// IsValid returns true if lang is one of the supported languages.
+// Und is not a valid language.
+func (lang Lang) IsValid() bool {
+	_, ok := Langs[lang]
+	return ok
+}
+
 // Langs represents a map of Lang to language name.
 var Langs = map[Lang]string{
 	Afr: "Afrikaans",
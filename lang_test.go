@@ -185,7 +185,7 @@ func TestLangToString(t *testing.T) {
 		Ydd: "ydd",
 		Yor: "yor",
 		Zul: "zul",
-		-1:  "",
+		Und: "und",
 	}
 
 	for lang, codeStr := range tests {
@@ -292,3 +292,32 @@ func TestLangToStringShort(t *testing.T) {
 		}
 	}
 }
+
+func TestLangIsValid(t *testing.T) {
+	tests := map[Lang]bool{
+		Afr:     true,
+		Eng:     true,
+		Zul:     true,
+		Und:     false,
+		Zul + 1: false,
+	}
+
+	for lang, want := range tests {
+		got := lang.IsValid()
+		if got != want {
+			t.Fatalf("%d: want %t got %t", lang, want, got)
+		}
+	}
+}
+
+func TestUnd(t *testing.T) {
+	if Und.String() != "Undetermined" {
+		t.Fatalf("want Undetermined got %s", Und.String())
+	}
+	if Und.Iso6393() != "und" {
+		t.Fatalf("want und got %s", Und.Iso6393())
+	}
+	if CodeToLang("und") != Und {
+		t.Fatalf("want %d got %d", Und, CodeToLang("und"))
+	}
+}
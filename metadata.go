@@ -0,0 +1,167 @@
+package whatlanggo
+
+import "unicode"
+
+// Direction is the direction in which a language is written.
+type Direction int
+
+const (
+	// LeftToRight is used by most scripts, such as Latin, Cyrillic or Devanagari.
+	LeftToRight Direction = iota
+	// RightToLeft is used by the Arabic and Hebrew scripts.
+	RightToLeft
+)
+
+// String returns "ltr" or "rtl", as used by the HTML dir attribute.
+func (direction Direction) String() string {
+	if direction == RightToLeft {
+		return "rtl"
+	}
+	return "ltr"
+}
+
+// Metadata describes a language.
+type Metadata struct {
+	Lang Lang
+	// Name is the English name of the language, as returned by Lang.String.
+	Name string
+	// NativeName is the name of the language in the language itself.
+	NativeName string
+	// Family is the language family, such as "Indo-European" or "Niger-Congo".
+	Family string
+	// Branch is the branch of the language family, such as "Germanic" or "Bantu".
+	Branch string
+	// Direction is the writing direction of the default script of the language.
+	Direction Direction
+	// Scripts are the scripts the language is usually written in, the default one first.
+	Scripts []*unicode.RangeTable
+	// Iso6391 is the ISO 639-1 code, empty when there is none.
+	Iso6391 string
+	// Iso6392B and Iso6392T are the ISO 639-2 bibliographic and terminology codes,
+	// empty when there are none. They are the same for most languages.
+	Iso6392B string
+	Iso6392T string
+	// Iso6393 is the ISO 639-3 code.
+	Iso6393 string
+	// Macrolanguage is the ISO 639-3 code of the macrolanguage the language is a member
+	// of, such as "zho" for Cmn, empty when there is none.
+	Macrolanguage string
+}
+
+// Metadata returns the metadata of lang. It returns false when lang is not valid.
+func (lang Lang) Metadata() (Metadata, bool) {
+	m, ok := langMetadata[lang]
+	if !ok {
+		return Metadata{}, false
+	}
+
+	m.Lang = lang
+	m.Name = lang.String()
+	m.Iso6391 = lang.Iso6391()
+	m.Iso6393 = lang.Iso6393()
+	m.Scripts = append([]*unicode.RangeTable(nil), m.Scripts...)
+	return m, true
+}
+
+// Direction returns the writing direction of lang. Languages that are not valid are
+// considered to be written from left to right.
+func (lang Lang) Direction() Direction {
+	return langMetadata[lang].Direction
+}
+
+// Scripts commonly shared by the languages below.
+var (
+	latin    = []*unicode.RangeTable{unicode.Latin}
+	cyrillic = []*unicode.RangeTable{unicode.Cyrillic}
+	arabic   = []*unicode.RangeTable{unicode.Arabic}
+	deva     = []*unicode.RangeTable{unicode.Devanagari}
+)
+
+// langMetadata holds the metadata of the supported languages. Lang, Name, Iso6391
+// and Iso6393 are filled by Lang.Metadata.
+var langMetadata = map[Lang]Metadata{
+	Afr: {NativeName: "Afrikaans", Family: "Indo-European", Branch: "Germanic", Scripts: latin, Iso6392B: "afr", Iso6392T: "afr"},
+	Aka: {NativeName: "Akan", Family: "Niger-Congo", Branch: "Kwa", Scripts: latin, Iso6392B: "aka", Iso6392T: "aka"},
+	Amh: {NativeName: "አማርኛ", Family: "Afro-Asiatic", Branch: "Semitic", Scripts: []*unicode.RangeTable{unicode.Ethiopic}, Iso6392B: "amh", Iso6392T: "amh"},
+	Arb: {NativeName: "العربية", Family: "Afro-Asiatic", Branch: "Semitic", Direction: RightToLeft, Scripts: arabic, Iso6392B: "ara", Iso6392T: "ara", Macrolanguage: "ara"},
+	Azj: {NativeName: "azərbaycan", Family: "Turkic", Branch: "Oghuz", Scripts: []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic}, Iso6392B: "aze", Iso6392T: "aze", Macrolanguage: "aze"},
+	Bel: {NativeName: "беларуская", Family: "Indo-European", Branch: "Slavic", Scripts: cyrillic, Iso6392B: "bel", Iso6392T: "bel"},
+	Ben: {NativeName: "বাংলা", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: []*unicode.RangeTable{unicode.Bengali}, Iso6392B: "ben", Iso6392T: "ben"},
+	Bho: {NativeName: "भोजपुरी", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: deva, Iso6392B: "bho", Iso6392T: "bho"},
+	Bul: {NativeName: "български", Family: "Indo-European", Branch: "Slavic", Scripts: cyrillic, Iso6392B: "bul", Iso6392T: "bul"},
+	Ceb: {NativeName: "Binisaya", Family: "Austronesian", Branch: "Malayo-Polynesian", Scripts: latin, Iso6392B: "ceb", Iso6392T: "ceb"},
+	Ces: {NativeName: "čeština", Family: "Indo-European", Branch: "Slavic", Scripts: latin, Iso6392B: "cze", Iso6392T: "ces"},
+	Cmn: {NativeName: "中文", Family: "Sino-Tibetan", Branch: "Sinitic", Scripts: []*unicode.RangeTable{unicode.Han}, Iso6392B: "chi", Iso6392T: "zho", Macrolanguage: "zho"},
+	Dan: {NativeName: "dansk", Family: "Indo-European", Branch: "Germanic", Scripts: latin, Iso6392B: "dan", Iso6392T: "dan"},
+	Deu: {NativeName: "Deutsch", Family: "Indo-European", Branch: "Germanic", Scripts: latin, Iso6392B: "ger", Iso6392T: "deu"},
+	Ell: {NativeName: "Ελληνικά", Family: "Indo-European", Branch: "Hellenic", Scripts: []*unicode.RangeTable{unicode.Greek}, Iso6392B: "gre", Iso6392T: "ell"},
+	Eng: {NativeName: "English", Family: "Indo-European", Branch: "Germanic", Scripts: latin, Iso6392B: "eng", Iso6392T: "eng"},
+	Epo: {NativeName: "esperanto", Family: "Constructed", Branch: "International auxiliary", Scripts: latin, Iso6392B: "epo", Iso6392T: "epo"},
+	Est: {NativeName: "eesti", Family: "Uralic", Branch: "Finnic", Scripts: latin, Iso6392B: "est", Iso6392T: "est"},
+	Fin: {NativeName: "suomi", Family: "Uralic", Branch: "Finnic", Scripts: latin, Iso6392B: "fin", Iso6392T: "fin"},
+	Fra: {NativeName: "français", Family: "Indo-European", Branch: "Romance", Scripts: latin, Iso6392B: "fre", Iso6392T: "fra"},
+	Guj: {NativeName: "ગુજરાતી", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: []*unicode.RangeTable{unicode.Gujarati}, Iso6392B: "guj", Iso6392T: "guj"},
+	Hat: {NativeName: "Kreyòl ayisyen", Family: "Creole", Branch: "French-based", Scripts: latin, Iso6392B: "hat", Iso6392T: "hat"},
+	Hau: {NativeName: "Hausa", Family: "Afro-Asiatic", Branch: "Chadic", Scripts: []*unicode.RangeTable{unicode.Latin, unicode.Arabic}, Iso6392B: "hau", Iso6392T: "hau"},
+	Heb: {NativeName: "עברית", Family: "Afro-Asiatic", Branch: "Semitic", Direction: RightToLeft, Scripts: []*unicode.RangeTable{unicode.Hebrew}, Iso6392B: "heb", Iso6392T: "heb"},
+	Hin: {NativeName: "हिन्दी", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: deva, Iso6392B: "hin", Iso6392T: "hin"},
+	Hrv: {NativeName: "hrvatski", Family: "Indo-European", Branch: "Slavic", Scripts: latin, Iso6392B: "hrv", Iso6392T: "hrv", Macrolanguage: "hbs"},
+	Hun: {NativeName: "magyar", Family: "Uralic", Branch: "Ugric", Scripts: latin, Iso6392B: "hun", Iso6392T: "hun"},
+	Ibo: {NativeName: "Igbo", Family: "Niger-Congo", Branch: "Volta-Niger", Scripts: latin, Iso6392B: "ibo", Iso6392T: "ibo"},
+	Ilo: {NativeName: "Ilokano", Family: "Austronesian", Branch: "Malayo-Polynesian", Scripts: latin, Iso6392B: "ilo", Iso6392T: "ilo"},
+	Ind: {NativeName: "Indonesia", Family: "Austronesian", Branch: "Malayo-Polynesian", Scripts: latin, Iso6392B: "ind", Iso6392T: "ind", Macrolanguage: "msa"},
+	Ita: {NativeName: "italiano", Family: "Indo-European", Branch: "Romance", Scripts: latin, Iso6392B: "ita", Iso6392T: "ita"},
+	Jav: {NativeName: "Basa Jawa", Family: "Austronesian", Branch: "Malayo-Polynesian", Scripts: latin, Iso6392B: "jav", Iso6392T: "jav"},
+	Jpn: {NativeName: "日本語", Family: "Japonic", Branch: "Japanese", Scripts: []*unicode.RangeTable{_HiraganaKatakana, unicode.Han}, Iso6392B: "jpn", Iso6392T: "jpn"},
+	Kan: {NativeName: "ಕನ್ನಡ", Family: "Dravidian", Branch: "South Dravidian", Scripts: []*unicode.RangeTable{unicode.Kannada}, Iso6392B: "kan", Iso6392T: "kan"},
+	Kat: {NativeName: "ქართული", Family: "Kartvelian", Branch: "Karto-Zan", Scripts: []*unicode.RangeTable{unicode.Georgian}, Iso6392B: "geo", Iso6392T: "kat"},
+	Khm: {NativeName: "ខ្មែរ", Family: "Austroasiatic", Branch: "Khmeric", Scripts: []*unicode.RangeTable{unicode.Khmer}, Iso6392B: "khm", Iso6392T: "khm"},
+	Kin: {NativeName: "Kinyarwanda", Family: "Niger-Congo", Branch: "Bantu", Scripts: latin, Iso6392B: "kin", Iso6392T: "kin"},
+	Kor: {NativeName: "한국어", Family: "Koreanic", Branch: "Korean", Scripts: []*unicode.RangeTable{unicode.Hangul, unicode.Han}, Iso6392B: "kor", Iso6392T: "kor"},
+	Kur: {NativeName: "Kurdî", Family: "Indo-European", Branch: "Iranian", Scripts: []*unicode.RangeTable{unicode.Latin, unicode.Arabic}, Iso6392B: "kur", Iso6392T: "kur"},
+	Lav: {NativeName: "latviešu", Family: "Indo-European", Branch: "Baltic", Scripts: latin, Iso6392B: "lav", Iso6392T: "lav"},
+	Lit: {NativeName: "lietuvių", Family: "Indo-European", Branch: "Baltic", Scripts: latin, Iso6392B: "lit", Iso6392T: "lit"},
+	Mai: {NativeName: "मैथिली", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: deva, Iso6392B: "mai", Iso6392T: "mai"},
+	Mal: {NativeName: "മലയാളം", Family: "Dravidian", Branch: "South Dravidian", Scripts: []*unicode.RangeTable{unicode.Malayalam}, Iso6392B: "mal", Iso6392T: "mal"},
+	Mar: {NativeName: "मराठी", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: deva, Iso6392B: "mar", Iso6392T: "mar"},
+	Mkd: {NativeName: "македонски", Family: "Indo-European", Branch: "Slavic", Scripts: cyrillic, Iso6392B: "mac", Iso6392T: "mkd"},
+	Mlg: {NativeName: "Malagasy", Family: "Austronesian", Branch: "Malayo-Polynesian", Scripts: latin, Iso6392B: "mlg", Iso6392T: "mlg"},
+	Mya: {NativeName: "မြန်မာ", Family: "Sino-Tibetan", Branch: "Lolo-Burmese", Scripts: []*unicode.RangeTable{unicode.Myanmar}, Iso6392B: "bur", Iso6392T: "mya"},
+	Nep: {NativeName: "नेपाली", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: deva, Iso6392B: "nep", Iso6392T: "nep"},
+	Nld: {NativeName: "Nederlands", Family: "Indo-European", Branch: "Germanic", Scripts: latin, Iso6392B: "dut", Iso6392T: "nld"},
+	Nno: {NativeName: "nynorsk", Family: "Indo-European", Branch: "Germanic", Scripts: latin, Iso6392B: "nno", Iso6392T: "nno", Macrolanguage: "nor"},
+	Nob: {NativeName: "norsk bokmål", Family: "Indo-European", Branch: "Germanic", Scripts: latin, Iso6392B: "nob", Iso6392T: "nob", Macrolanguage: "nor"},
+	Nya: {NativeName: "Chichewa", Family: "Niger-Congo", Branch: "Bantu", Scripts: latin, Iso6392B: "nya", Iso6392T: "nya"},
+	Ori: {NativeName: "ଓଡ଼ିଆ", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: []*unicode.RangeTable{unicode.Oriya}, Iso6392B: "ori", Iso6392T: "ori"},
+	Orm: {NativeName: "Oromoo", Family: "Afro-Asiatic", Branch: "Cushitic", Scripts: latin, Iso6392B: "orm", Iso6392T: "orm"},
+	Pan: {NativeName: "ਪੰਜਾਬੀ", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: []*unicode.RangeTable{unicode.Gurmukhi, unicode.Arabic}, Iso6392B: "pan", Iso6392T: "pan"},
+	Pes: {NativeName: "فارسی", Family: "Indo-European", Branch: "Iranian", Direction: RightToLeft, Scripts: arabic, Iso6392B: "per", Iso6392T: "fas", Macrolanguage: "fas"},
+	Pol: {NativeName: "polski", Family: "Indo-European", Branch: "Slavic", Scripts: latin, Iso6392B: "pol", Iso6392T: "pol"},
+	Por: {NativeName: "português", Family: "Indo-European", Branch: "Romance", Scripts: latin, Iso6392B: "por", Iso6392T: "por"},
+	Ron: {NativeName: "română", Family: "Indo-European", Branch: "Romance", Scripts: latin, Iso6392B: "rum", Iso6392T: "ron"},
+	Run: {NativeName: "Ikirundi", Family: "Niger-Congo", Branch: "Bantu", Scripts: latin, Iso6392B: "run", Iso6392T: "run"},
+	Rus: {NativeName: "русский", Family: "Indo-European", Branch: "Slavic", Scripts: cyrillic, Iso6392B: "rus", Iso6392T: "rus"},
+	Sin: {NativeName: "සිංහල", Family: "Indo-European", Branch: "Indo-Aryan", Scripts: []*unicode.RangeTable{unicode.Sinhala}, Iso6392B: "sin", Iso6392T: "sin"},
+	Skr: {NativeName: "سرائیکی", Family: "Indo-European", Branch: "Indo-Aryan", Direction: RightToLeft, Scripts: arabic, Macrolanguage: "lah"},
+	Slv: {NativeName: "slovenščina", Family: "Indo-European", Branch: "Slavic", Scripts: latin, Iso6392B: "slv", Iso6392T: "slv"},
+	Sna: {NativeName: "chiShona", Family: "Niger-Congo", Branch: "Bantu", Scripts: latin, Iso6392B: "sna", Iso6392T: "sna"},
+	Som: {NativeName: "Soomaali", Family: "Afro-Asiatic", Branch: "Cushitic", Scripts: latin, Iso6392B: "som", Iso6392T: "som"},
+	Spa: {NativeName: "español", Family: "Indo-European", Branch: "Romance", Scripts: latin, Iso6392B: "spa", Iso6392T: "spa"},
+	Srp: {NativeName: "српски", Family: "Indo-European", Branch: "Slavic", Scripts: []*unicode.RangeTable{unicode.Cyrillic, unicode.Latin}, Iso6392B: "srp", Iso6392T: "srp", Macrolanguage: "hbs"},
+	Swe: {NativeName: "svenska", Family: "Indo-European", Branch: "Germanic", Scripts: latin, Iso6392B: "swe", Iso6392T: "swe"},
+	Tam: {NativeName: "தமிழ்", Family: "Dravidian", Branch: "South Dravidian", Scripts: []*unicode.RangeTable{unicode.Tamil}, Iso6392B: "tam", Iso6392T: "tam"},
+	Tel: {NativeName: "తెలుగు", Family: "Dravidian", Branch: "South-Central Dravidian", Scripts: []*unicode.RangeTable{unicode.Telugu}, Iso6392B: "tel", Iso6392T: "tel"},
+	Tgl: {NativeName: "Tagalog", Family: "Austronesian", Branch: "Malayo-Polynesian", Scripts: latin, Iso6392B: "tgl", Iso6392T: "tgl"},
+	Tha: {NativeName: "ไทย", Family: "Kra-Dai", Branch: "Tai", Scripts: []*unicode.RangeTable{unicode.Thai}, Iso6392B: "tha", Iso6392T: "tha"},
+	Tir: {NativeName: "ትግርኛ", Family: "Afro-Asiatic", Branch: "Semitic", Scripts: []*unicode.RangeTable{unicode.Ethiopic}, Iso6392B: "tir", Iso6392T: "tir"},
+	Tuk: {NativeName: "Türkmen dili", Family: "Turkic", Branch: "Oghuz", Scripts: []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic}, Iso6392B: "tuk", Iso6392T: "tuk"},
+	Tur: {NativeName: "Türkçe", Family: "Turkic", Branch: "Oghuz", Scripts: latin, Iso6392B: "tur", Iso6392T: "tur"},
+	Uig: {NativeName: "ئۇيغۇرچە", Family: "Turkic", Branch: "Karluk", Direction: RightToLeft, Scripts: []*unicode.RangeTable{unicode.Arabic, unicode.Cyrillic, unicode.Latin}, Iso6392B: "uig", Iso6392T: "uig"},
+	Ukr: {NativeName: "українська", Family: "Indo-European", Branch: "Slavic", Scripts: cyrillic, Iso6392B: "ukr", Iso6392T: "ukr"},
+	Urd: {NativeName: "اردو", Family: "Indo-European", Branch: "Indo-Aryan", Direction: RightToLeft, Scripts: arabic, Iso6392B: "urd", Iso6392T: "urd"},
+	Uzb: {NativeName: "o‘zbek", Family: "Turkic", Branch: "Karluk", Scripts: []*unicode.RangeTable{unicode.Latin, unicode.Cyrillic}, Iso6392B: "uzb", Iso6392T: "uzb"},
+	Vie: {NativeName: "Tiếng Việt", Family: "Austroasiatic", Branch: "Vietic", Scripts: latin, Iso6392B: "vie", Iso6392T: "vie"},
+	Ydd: {NativeName: "ייִדיש", Family: "Indo-European", Branch: "Germanic", Direction: RightToLeft, Scripts: []*unicode.RangeTable{unicode.Hebrew}, Iso6392B: "yid", Iso6392T: "yid", Macrolanguage: "yid"},
+	Yor: {NativeName: "Èdè Yorùbá", Family: "Niger-Congo", Branch: "Volta-Niger", Scripts: latin, Iso6392B: "yor", Iso6392T: "yor"},
+	Zul: {NativeName: "isiZulu", Family: "Niger-Congo", Branch: "Bantu", Scripts: latin, Iso6392B: "zul", Iso6392T: "zul"},
+}
@@ -0,0 +1,59 @@
+package whatlanggo
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestLangMetadata(t *testing.T) {
+	rtl := map[Lang]bool{Arb: true, Heb: true, Pes: true, Skr: true, Uig: true, Urd: true, Ydd: true}
+
+	for lang := range Langs {
+		m, ok := lang.Metadata()
+		if !ok {
+			t.Fatalf("%s: no metadata", LangToString(lang))
+		}
+		if m.Lang != lang || m.Name != lang.String() || m.Iso6393 != lang.Iso6393() || m.Iso6391 != lang.Iso6391() {
+			t.Fatalf("%s: got %v", LangToString(lang), m)
+		}
+		if m.NativeName == "" || m.Family == "" || m.Branch == "" || len(m.Scripts) == 0 {
+			t.Fatalf("%s: incomplete metadata %v", LangToString(lang), m)
+		}
+		if len(m.Iso6392B) != 3 && lang != Skr || len(m.Iso6392T) != len(m.Iso6392B) {
+			t.Fatalf("%s: got ISO 639-2 codes %q %q", LangToString(lang), m.Iso6392B, m.Iso6392T)
+		}
+		if (m.Direction == RightToLeft) != rtl[lang] || lang.Direction() != m.Direction {
+			t.Fatalf("%s: got direction %v", LangToString(lang), m.Direction)
+		}
+	}
+
+	if _, ok := Und.Metadata(); ok {
+		t.Fatal("want no metadata for Und")
+	}
+	if Und.Direction() != LeftToRight {
+		t.Fatalf("want %v got %v", LeftToRight, Und.Direction())
+	}
+}
+
+func TestLangMetadataValues(t *testing.T) {
+	m, _ := Cmn.Metadata()
+	if m.NativeName != "中文" || m.Iso6392B != "chi" || m.Iso6392T != "zho" || m.Macrolanguage != "zho" || m.Branch != "Sinitic" {
+		t.Fatalf("got %v", m)
+	}
+
+	m, _ = Srp.Metadata()
+	if len(m.Scripts) != 2 || m.Scripts[0] != unicode.Cyrillic || m.Scripts[1] != unicode.Latin {
+		t.Fatalf("got %v", m.Scripts)
+	}
+
+	//Scripts is a copy.
+	m.Scripts[0] = unicode.Latin
+	m, _ = Srp.Metadata()
+	if m.Scripts[0] != unicode.Cyrillic {
+		t.Fatalf("want %s got %s", Scripts[unicode.Cyrillic], Scripts[m.Scripts[0]])
+	}
+
+	if Heb.Direction().String() != "rtl" || Eng.Direction().String() != "ltr" {
+		t.Fatalf("got %v %v", Heb.Direction(), Eng.Direction())
+	}
+}
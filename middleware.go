@@ -0,0 +1,61 @@
+package whatlanggo
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// MaxMiddlewareBodySize is the number of bytes of a request body analyzed by Middleware.
+// Longer bodies are not read further for detection, but are passed on unchanged.
+const MaxMiddlewareBodySize = 64 << 10
+
+type contextKey struct{}
+
+// NewContext returns a copy of ctx that carries info.
+func NewContext(ctx context.Context, info Info) context.Context {
+	return context.WithValue(ctx, contextKey{}, info)
+}
+
+// FromContext returns the Info stored in ctx by NewContext or Middleware, if any.
+func FromContext(ctx context.Context) (Info, bool) {
+	info, ok := ctx.Value(contextKey{}).(Info)
+	return info, ok
+}
+
+// Middleware returns net/http middleware that detects the language of request bodies
+// with DetectWithAcceptLanguageContext and options, and stores the result in the request
+// context, where handlers get it with FromContext. Only the first
+// MaxMiddlewareBodySize bytes of the body are analyzed, and handlers read the whole body
+// as if the middleware was not there. When reading the body fails, the request is passed
+// on without a result in its context. When the request is canceled or its deadline
+// expires during detection, the result is Und with ReasonCanceled.
+func Middleware(options Options) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var text string
+			if r.Body != nil {
+				prefix, err := ioutil.ReadAll(io.LimitReader(r.Body, MaxMiddlewareBodySize))
+				r.Body = readCloser{io.MultiReader(bytes.NewReader(prefix), r.Body), r.Body}
+				if err != nil {
+					next.ServeHTTP(w, r)
+					return
+				}
+				text = string(prefix)
+			}
+
+			// On cancellation, DetectWithAcceptLanguageContext returns Und with ReasonCanceled.
+			info, _ := DetectWithAcceptLanguageContext(r.Context(), text, r.Header.Get("Accept-Language"), options)
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), info)))
+		})
+	}
+}
+
+// readCloser reads from a Reader and closes a Closer, so that the body of a request can
+// be read again after its beginning was consumed.
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
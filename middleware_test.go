@@ -0,0 +1,68 @@
+package whatlanggo
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMiddleware(t *testing.T) {
+	var info Info
+	var ok bool
+	var body string
+	handler := Middleware(Options{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		info, ok = FromContext(r.Context())
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body = string(b)
+	}))
+
+	tests := []struct {
+		body   string
+		header string
+		want   Lang
+	}{
+		{"Ĉu vi ne volas eklerni Esperanton? Bonvolu! Estas unu de la plej bonaj aferoj!", "", Epo},
+		{"Como estás tu hoje", "pt-BR", Por},
+		{"", "pt-BR", Und},
+		{strings.Repeat("Where there is a will there is a way. ", MaxMiddlewareBodySize/10), "", Eng},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body))
+		r.Header.Set("Accept-Language", tt.header)
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+		if !ok || info.Lang != tt.want {
+			t.Fatalf("%q: want %v got %v (%t)", tt.header, LangToString(tt.want), LangToString(info.Lang), ok)
+		}
+		if body != tt.body {
+			t.Fatalf("the body of %d bytes was changed to %d bytes", len(tt.body), len(body))
+		}
+	}
+
+	//Detection stops when the request is canceled.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tests[0].body)).WithContext(ctx)
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	if !ok || info.Lang != Und || info.Reason != ReasonCanceled {
+		t.Fatalf("want %v with %v got %v with %v (%t)", LangToString(Und), ReasonCanceled, LangToString(info.Lang), info.Reason, ok)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Fatal("want no info in an empty context")
+	}
+
+	want := Info{Lang: Eng, Confidence: 1}
+	got, ok := FromContext(NewContext(context.Background(), want))
+	if !ok || got != want {
+		t.Fatalf("want %v got %v (%t)", want, got, ok)
+	}
+}
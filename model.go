@@ -0,0 +1,167 @@
+package whatlanggo
+
+import (
+	"context"
+	"math"
+	"sort"
+	"unicode"
+)
+
+// Features are the features of a text that a Model scores languages with.
+type Features struct {
+	// Text is the text itself.
+	Text string
+	// Script is the script the text is detected in.
+	Script *unicode.RangeTable
+	// Trigrams maps the trigrams of the text to their rank, from 0 for the most frequent one.
+	Trigrams map[string]int
+	// Words are the lowercase words of the text.
+	Words []string
+	// ShortTextTrigrams is the Options.ShortTextTrigrams of the detection, which models
+	// use to tell short texts.
+	ShortTextTrigrams int
+}
+
+// newFeatures extracts the features of text, written in script, detected with options.
+func newFeatures(text string, script *unicode.RangeTable, options Options) Features {
+	return Features{
+		Text:              text,
+		Script:            script,
+		Trigrams:          getTrigramsWithPositions(text),
+		Words:             textWords(text),
+		ShortTextTrigrams: options.ShortTextTrigrams,
+	}
+}
+
+// LangScore is the score of a language for a text.
+type LangScore struct {
+	Lang  Lang    `json:"lang"`
+	Score float64 `json:"score"`
+}
+
+// Model scores the languages a text may be written in. Scores are log-likelihoods, or
+// any measure on the same scale: the higher, the more likely, and a difference of 1
+// between two languages means that the first one is e times as likely. Models are only
+// used for scripts shared by several languages.
+type Model interface {
+	// Score returns the score of every candidate language for a text, in any order.
+	// Candidates are written in the script of the features, and are never empty.
+	Score(features Features, candidates []Lang) []LangScore
+}
+
+// TrigramModel is the default model, which compares the ranks of the trigrams of the
+// text with the trigram profiles of the languages, and brings closer the languages
+// whose frequent words and marker words are found in the text. DetectWithOptions uses
+// it when Options.Model is nil.
+type TrigramModel struct{}
+
+// Score returns minus the distance between the text and the profile of every candidate
+// language, scaled by DefaultCalibration so that scores are log-likelihoods.
+func (TrigramModel) Score(features Features, candidates []Lang) []LangScore {
+	options := Options{Whitelist: make(map[Lang]bool, len(candidates)), ShortTextTrigrams: features.ShortTextTrigrams}
+	for _, lang := range candidates {
+		options.Whitelist[lang] = true
+	}
+
+	trigrams := packTrigrams(features.Trigrams)
+	langDistances := rankText(features.Text, trigrams, options, scriptProfileKeys[features.Script], scriptDictionaries[features.Script])
+	scale := DefaultCalibration.Scale
+	scores := make([]LangScore, len(langDistances))
+	for i, langDist := range langDistances {
+		scores[i] = LangScore{langDist.lang, -scale * float64(langDist.dist) / maxTrigramDistance}
+	}
+	return scores
+}
+
+// usesTrigramModel reports whether options select the default trigram model.
+func (options Options) usesTrigramModel() bool {
+	switch options.Model.(type) {
+	case nil, TrigramModel, *TrigramModel:
+		return true
+	default:
+		return false
+	}
+}
+
+// scoreLangs scores the allowed languages of script with the model of options, adds
+// the logarithm of their priors, and sorts them from the most to the least likely
+// language. Languages with the same score are sorted by their ISO 639-3 code. For an
+// Ensemble, it also returns the share of the votes of every language.
+func scoreLangs(features Features, options Options) ([]LangScore, map[Lang]float64) {
+	var candidates []Lang
+	for lang := range scriptProfiles[features.Script] {
+		if options.isAllowed(lang) {
+			candidates = append(candidates, lang)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	sortLangs(candidates)
+
+	var scores []LangScore
+	var votes map[Lang]float64
+	if ensemble, ok := options.Model.(*Ensemble); ok {
+		scores, votes = ensemble.score(features, candidates)
+	} else {
+		scores = options.Model.Score(features, candidates)
+	}
+	for i := range scores {
+		scores[i].Score += options.logPrior(scores[i].Lang)
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		if scores[i].Score == scores[j].Score {
+			return scores[i].Lang < scores[j].Lang
+		}
+		return scores[i].Score > scores[j].Score
+	})
+	return scores, votes
+}
+
+// detectLangWithModel detects the language of text, written in script, with the model
+// of options. The confidence is the probability that the runner-up is not as likely as
+// the winner, 1 - exp(second score - first score), scaled down for short texts. For an
+// Ensemble, it is also scaled by the share of the votes of the winner.
+func detectLangWithModel(ctx context.Context, text string, options Options, script *unicode.RangeTable) (Lang, float64, error) {
+	features := newFeatures(text, script, options)
+	if err := ctx.Err(); err != nil {
+		return Und, 0, err
+	}
+	scores, votes := scoreLangs(features, options)
+
+	switch len(scores) {
+	case 0:
+		return Und, 0, nil
+	case 1:
+		return scores[0].Lang, 1, nil
+	}
+
+	lang := scores[0].Lang
+	// Equal scores give a confidence of 0, not -0.
+	confidence := math.Max(0, -math.Expm1(scores[1].Score-scores[0].Score))
+	if votes != nil {
+		confidence *= votes[lang]
+	}
+	if options.isShortText(len(features.Trigrams)) {
+		confidence *= shortTextEvidence(text, len(features.Trigrams), options, lang, scriptDictionaries[script])
+	}
+	return lang, confidence, nil
+}
+
+// softmax converts scores, sorted as returned by scoreLangs, to probabilities.
+func softmax(scores []LangScore) []LangProbability {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	probabilities := make([]LangProbability, len(scores))
+	sum := 0.0
+	for i, score := range scores {
+		probabilities[i] = LangProbability{score.Lang, math.Exp(score.Score - scores[0].Score)}
+		sum += probabilities[i].Probability
+	}
+	for i := range probabilities {
+		probabilities[i].Probability /= sum
+	}
+	return probabilities
+}
@@ -0,0 +1,158 @@
+package whatlanggo
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// scoringModel hides the type of Model, so that detection goes through its Score method
+// even for TrigramModel.
+type scoringModel struct {
+	Model
+}
+
+func TestTrigramModel(t *testing.T) {
+	tests := []string{
+		"Ĉu vi ne volas eklerni Esperanton? Bonvolu! Estas unu de la plej bonaj aferoj!",
+		"Mi ne scias",
+		"Где мой телефон?",
+		"האקדמיה ללשון העברית",
+	}
+
+	for _, text := range tests {
+		want := Detect(text)
+		if got := DetectWithOptions(text, Options{Model: TrigramModel{}}); got != want {
+			t.Fatalf("%q: want %v got %v", text, want, got)
+		}
+
+		//TrigramModel.Score ranks languages like the default path.
+		got := DetectWithOptions(text, Options{Model: scoringModel{TrigramModel{}}})
+		if got.Lang != want.Lang || got.Script != want.Script || got.Confidence <= 0 {
+			t.Fatalf("%q: want %v got %v", text, want, got)
+		}
+	}
+
+	features := newFeatures("Where there is a will there is a way", unicode.Latin, Options{})
+	scores := TrigramModel{}.Score(features, []Lang{Eng, Deu})
+	if len(scores) != 2 || scores[0].Lang != Eng || scores[0].Score <= scores[1].Score {
+		t.Fatalf("want %v first got %v", LangToString(Eng), scores)
+	}
+}
+
+func TestNaiveBayesModel(t *testing.T) {
+	detector := NewDetector(Options{}).WithModel(NewProfileNaiveBayesModel())
+
+	tests := map[string]Lang{
+		"Ĉu vi ne volas eklerni Esperanton? Bonvolu! Estas unu de la plej bonaj aferoj!": Epo,
+		"Where there is a will there is a way":                                           Eng,
+		"Все люди рождаются свободными и равными в своем достоинстве и правах.":          Rus,
+		"Ich weiß nicht, was ich morgen machen werde.":                                   Deu,
+		"Je ne sais pas ce que je vais faire demain.":                                    Fra,
+	}
+
+	for text, want := range tests {
+		info := detector.Detect(text)
+		if info.Lang != want || info.Confidence <= 0 || info.Confidence > 1 {
+			t.Fatalf("%q: want %v got %v", text, LangToString(want), info)
+		}
+	}
+
+	//Single language scripts do not need a model.
+	if info := detector.Detect("ქართული ენა"); info.Lang != Kat || info.Confidence != 1 {
+		t.Fatalf("want %v got %v", LangToString(Kat), info)
+	}
+}
+
+func TestTrainNaiveBayesModel(t *testing.T) {
+	examples := readExamples(t)
+
+	//Naive Bayes favors the languages with the most training text.
+	corpus := map[Lang][]string{}
+	for code, text := range examples {
+		if runes := []rune(text); len(runes) >= 300 {
+			corpus[CodeToLang(code)] = []string{string(runes[:300])}
+		}
+	}
+	model, err := TrainNaiveBayesModel(corpus, 1, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//Windows of the training texts are recognized.
+	options := Options{Model: model}
+	for lang, texts := range corpus {
+		if _, ok := scriptProfiles[DetectScript(texts[0])]; !ok {
+			continue
+		}
+		words := strings.Fields(texts[0])
+		window := strings.Join(words[:len(words)/2], " ")
+		if got := DetectLangWithOptions(window, options); got != lang {
+			t.Fatalf("%q: want %v got %v", window, LangToString(lang), LangToString(got))
+		}
+	}
+
+	for _, orders := range [][2]int{{0, 3}, {1, 5}, {3, 2}} {
+		if _, err := TrainNaiveBayesModel(corpus, orders[0], orders[1]); err == nil {
+			t.Fatalf("%v: want an error", orders)
+		}
+	}
+}
+
+func TestAppendNgrams(t *testing.T) {
+	tests := []struct {
+		text string
+		n    int
+		want []string
+	}{
+		{"Ab, c!", 1, []string{"a", "b", "c"}},
+		{"Ab, c!", 2, []string{" a", "ab", "b ", " c", "c "}},
+		{"Ab, c!", 4, []string{" ab ", "ab c", "b c "}},
+		{"", 2, nil},
+	}
+
+	for _, tt := range tests {
+		got := appendNgrams(nil, tt.text, tt.n)
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Fatalf("%q %d: want %q got %q", tt.text, tt.n, tt.want, got)
+		}
+	}
+}
+
+type constantModel map[Lang]float64
+
+func (model constantModel) Score(features Features, candidates []Lang) []LangScore {
+	scores := make([]LangScore, len(candidates))
+	for i, lang := range candidates {
+		scores[i] = LangScore{lang, model[lang]}
+	}
+	return scores
+}
+
+func TestModelOptions(t *testing.T) {
+	text := "Where there is a will there is a way"
+	model := constantModel{Fra: 2, Deu: 1}
+
+	info := DetectWithOptions(text, Options{Model: model, ShortTextTrigrams: -1})
+	if info.Lang != Fra || math.Abs(info.Confidence-(1-math.Exp(-1))) > 1e-9 {
+		t.Fatalf("want %v with a confidence of 1-1/e got %v", LangToString(Fra), info)
+	}
+
+	//Priors are added to the scores.
+	if got := DetectLangWithOptions(text, Options{Model: model, Priors: map[Lang]float64{Deu: 10}}); got != Deu {
+		t.Fatalf("want %v got %v", LangToString(Deu), LangToString(got))
+	}
+	if got := DetectLangWithOptions(text, Options{Model: model, Blacklist: map[Lang]bool{Fra: true}}); got != Deu {
+		t.Fatalf("want %v got %v", LangToString(Deu), LangToString(got))
+	}
+
+	probabilities := Probabilities(text, Options{Model: model})
+	sum := 0.0
+	for _, p := range probabilities {
+		sum += p.Probability
+	}
+	if probabilities[0].Lang != Fra || probabilities[1].Lang != Deu || math.Abs(sum-1) > 1e-9 {
+		t.Fatalf("got probabilities %v", probabilities[:2])
+	}
+}
@@ -0,0 +1,181 @@
+package whatlanggo
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// NaiveBayesModel scores languages by the log-likelihood of the character n-grams of
+// the text, assuming that n-grams are independent, as langdetect and CLD do. It is
+// slower than TrigramModel, but takes every n-gram of the text into account rather than
+// the ranks of the most frequent ones. TrainNaiveBayesModel trains a model of 1- to
+// 4-grams on a labeled corpus. whatlanggo ships no such corpus, so it ships no trained
+// model: NewProfileNaiveBayesModel only approximates one from the trigram profiles.
+type NaiveBayesModel struct {
+	minN, maxN int
+	// logProbs maps the n-grams of every language to their log probability.
+	logProbs map[Lang]map[string]float64
+	// unseen holds the log probability of an n-gram missing from logProbs, for every
+	// language and every order from minN to maxN.
+	unseen map[Lang][]float64
+	// uniform holds the log probability of any n-gram in a language missing from the
+	// model, for every order.
+	uniform []float64
+}
+
+// Score returns the log-likelihood of the n-grams of the text for every candidate language.
+func (model *NaiveBayesModel) Score(features Features, candidates []Lang) []LangScore {
+	var grams []string
+	for n := model.minN; n <= model.maxN; n++ {
+		grams = appendNgrams(grams, features.Text, n)
+	}
+
+	scores := make([]LangScore, len(candidates))
+	for i, lang := range candidates {
+		logProbs, unseen := model.logProbs[lang], model.unseen[lang]
+		if unseen == nil {
+			unseen = model.uniform
+		}
+		score := 0.0
+		for _, gram := range grams {
+			if logProb, ok := logProbs[gram]; ok {
+				score += logProb
+			} else {
+				score += unseen[len([]rune(gram))-model.minN]
+			}
+		}
+		scores[i] = LangScore{lang, score}
+	}
+	return scores
+}
+
+// NewProfileNaiveBayesModel returns a naive Bayes model of unigrams, bigrams and
+// trigrams approximated from the trigram profiles of the languages: the frequency of a
+// trigram is taken to be inversely proportional to its rank, following Zipf's law, and
+// the frequencies of unigrams and bigrams are summed up from the trigrams containing
+// them. It is not trained on text and is not the model of 1- to 4-grams of langdetect:
+// the profiles hold no n-gram counts and no 4-grams, and it draws on the same 300
+// trigrams per language as TrigramModel, so it weighs the same evidence differently and
+// is no more accurate. Train a model on a corpus with TrainNaiveBayesModel for that.
+// Building the model takes a few milliseconds, so it should be reused.
+func NewProfileNaiveBayesModel() *NaiveBayesModel {
+	counts := map[Lang]map[string]float64{}
+	for _, profiles := range scriptProfiles {
+		for lang, trigrams := range profiles {
+			if counts[lang] == nil {
+				counts[lang] = map[string]float64{}
+			}
+			for rank, trigram := range trigrams {
+				// In running text, every character is the middle of one trigram, and every
+				// bigram starts one trigram and ends another one.
+				count := zipfCount / float64(rank+1)
+				runes := []rune(trigram)
+				counts[lang][trigram] += count
+				counts[lang][string(runes[:2])] += count / 2
+				counts[lang][string(runes[1:])] += count / 2
+				if runes[1] != ' ' {
+					counts[lang][string(runes[1])] += count
+				}
+			}
+		}
+	}
+	return newNaiveBayesModel(counts, 1, 3)
+}
+
+// zipfCount is the count of the most frequent trigram of a profile in the corpus that
+// NewProfileNaiveBayesModel assumes.
+const zipfCount = 100000
+
+// TrainNaiveBayesModel trains a naive Bayes model of the n-grams of orders minN to maxN
+// on a labeled corpus, for instance texts of your own traffic, mapping languages to
+// texts written in them. Orders range from 1 to 4. Naive Bayes favors the languages
+// with the most training text, so the corpus should hold about as much text for every
+// language.
+func TrainNaiveBayesModel(corpus map[Lang][]string, minN, maxN int) (*NaiveBayesModel, error) {
+	if minN < 1 || maxN > 4 || minN > maxN {
+		return nil, fmt.Errorf("whatlanggo: invalid n-gram orders %d to %d", minN, maxN)
+	}
+
+	counts := make(map[Lang]map[string]float64, len(corpus))
+	for lang, texts := range corpus {
+		langCounts := map[string]float64{}
+		for _, text := range texts {
+			for n := minN; n <= maxN; n++ {
+				for _, gram := range appendNgrams(nil, text, n) {
+					langCounts[gram]++
+				}
+			}
+		}
+		counts[lang] = langCounts
+	}
+	return newNaiveBayesModel(counts, minN, maxN), nil
+}
+
+// newNaiveBayesModel estimates the probabilities of n-grams from their counts with
+// add-one smoothing, over the vocabulary of every order in all the languages.
+func newNaiveBayesModel(counts map[Lang]map[string]float64, minN, maxN int) *NaiveBayesModel {
+	orders := maxN - minN + 1
+	vocabulary := make([]map[string]bool, orders)
+	for i := range vocabulary {
+		vocabulary[i] = map[string]bool{}
+	}
+	for _, langCounts := range counts {
+		for gram := range langCounts {
+			vocabulary[len([]rune(gram))-minN][gram] = true
+		}
+	}
+
+	model := &NaiveBayesModel{
+		minN:     minN,
+		maxN:     maxN,
+		logProbs: make(map[Lang]map[string]float64, len(counts)),
+		unseen:   make(map[Lang][]float64, len(counts)),
+		uniform:  make([]float64, orders),
+	}
+	for i := range model.uniform {
+		model.uniform[i] = -math.Log(float64(len(vocabulary[i])) + 1)
+	}
+	for lang, langCounts := range counts {
+		totals := make([]float64, orders)
+		for gram, count := range langCounts {
+			totals[len([]rune(gram))-minN] += count
+		}
+
+		unseen := make([]float64, orders)
+		for i := range unseen {
+			unseen[i] = -math.Log(totals[i] + float64(len(vocabulary[i])) + 1)
+		}
+		logProbs := make(map[string]float64, len(langCounts))
+		for gram, count := range langCounts {
+			logProbs[gram] = math.Log(count+1) + unseen[len([]rune(gram))-minN]
+		}
+		model.logProbs[lang] = logProbs
+		model.unseen[lang] = unseen
+	}
+	return model
+}
+
+// appendNgrams appends to grams the n-grams of text, normalized like its trigrams:
+// lowercase, with punctuation and digits replaced by spaces, runs of spaces collapsed
+// and words surrounded by spaces. N-grams made of a single space are left out.
+func appendNgrams(grams []string, text string, n int) []string {
+	runes := []rune{' '}
+	for _, r := range text {
+		r = unicode.ToLower(toTrigramChar(r))
+		if r != ' ' || runes[len(runes)-1] != ' ' {
+			runes = append(runes, r)
+		}
+	}
+	if runes[len(runes)-1] != ' ' {
+		runes = append(runes, ' ')
+	}
+
+	for i := 0; i+n <= len(runes); i++ {
+		if gram := string(runes[i : i+n]); strings.TrimSpace(gram) != "" {
+			grams = append(grams, gram)
+		}
+	}
+	return grams
+}
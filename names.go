@@ -0,0 +1,13 @@
+package whatlanggo
+
+//go:generate go run -C internal/gennames . -o ../../names_data.go
+
+// NameIn returns the name of lang in the display language, e.g. Deu.NameIn(Fra) is
+// "allemand". The names come from CLDR and are bundled with the package. The English
+// name returned by String is used when the display language or the name is not available.
+func (lang Lang) NameIn(display Lang) string {
+	if name, ok := localizedNames[display][lang]; ok {
+		return name
+	}
+	return lang.String()
+}
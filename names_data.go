@@ -0,0 +1,5924 @@
+// Code generated by internal/gennames from CLDR data; DO NOT EDIT.
+
+package whatlanggo
+
+// localizedNames maps display languages to the names of languages in them.
+// Names missing from CLDR are left out.
+var localizedNames = map[Lang]map[Lang]string{
+	Afr: {
+		Afr: "Afrikaans",
+		Aka: "Akan",
+		Amh: "Amharies",
+		Arb: "Arabies",
+		Azj: "Azerbeidjans",
+		Bel: "Belarussies",
+		Ben: "Bengaals",
+		Bho: "Bhojpuri",
+		Bul: "Bulgaars",
+		Ceb: "Cebuano",
+		Ces: "Tsjeggies",
+		Cmn: "Sjinees",
+		Dan: "Deens",
+		Deu: "Duits",
+		Ell: "Grieks",
+		Eng: "Engels",
+		Epo: "Esperanto",
+		Est: "Estnies",
+		Fin: "Fins",
+		Fra: "Frans",
+		Guj: "Goedjarati",
+		Hat: "Haïtiaans",
+		Hau: "Hausa",
+		Heb: "Hebreeus",
+		Hin: "Hindi",
+		Hrv: "Kroaties",
+		Hun: "Hongaars",
+		Ibo: "Igbo",
+		Ilo: "Iloko",
+		Ind: "Indonesies",
+		Ita: "Italiaans",
+		Jav: "Javaans",
+		Jpn: "Japannees",
+		Kan: "Kannada",
+		Kat: "Georgies",
+		Khm: "Khmer",
+		Kin: "Rwandees",
+		Kor: "Koreaans",
+		Kur: "Koerdies",
+		Lav: "Letties",
+		Lit: "Litaus",
+		Mai: "Maithili",
+		Mal: "Malabaars",
+		Mar: "Marathi",
+		Mkd: "Masedonies",
+		Mlg: "Malgassies",
+		Mya: "Birmaans",
+		Nep: "Nepalees",
+		Nld: "Nederlands",
+		Nno: "Noorweegse Nynorsk",
+		Nob: "Noorse Bokmål",
+		Nya: "Nyanja",
+		Ori: "Oriya",
+		Orm: "Oromo",
+		Pan: "Pandjabi",
+		Pes: "Persies",
+		Pol: "Pools",
+		Por: "Portugees",
+		Ron: "Roemeens",
+		Run: "Rundi",
+		Rus: "Russies",
+		Sin: "Sinhala",
+		Slv: "Sloweens",
+		Sna: "Shona",
+		Som: "Somalies",
+		Spa: "Spaans",
+		Srp: "Serwies",
+		Swe: "Sweeds",
+		Tam: "Tamil",
+		Tel: "Teloegoe",
+		Tgl: "Filippyns",
+		Tha: "Thai",
+		Tir: "Tigrinya",
+		Tuk: "Turkmeens",
+		Tur: "Turks",
+		Uig: "Uighur",
+		Ukr: "Oekraïens",
+		Urd: "Oerdoe",
+		Uzb: "Oezbeeks",
+		Vie: "Viëtnamees",
+		Ydd: "Jiddisj",
+		Yor: "Yoruba",
+		Zul: "Zoeloe",
+	},
+	Aka: {
+		Aka: "Akan",
+		Amh: "Amarik",
+		Arb: "Arabik",
+		Bel: "Belarus kasa",
+		Ben: "Bengali kasa",
+		Bul: "Bɔlgeria kasa",
+		Ces: "Kyɛk kasa",
+		Cmn: "Kyaena kasa",
+		Deu: "Gyaaman",
+		Ell: "Greek kasa",
+		Eng: "Borɔfo",
+		Fra: "Frɛnkye",
+		Hau: "Hausa",
+		Hin: "Hindi",
+		Hun: "Hangri kasa",
+		Ibo: "Igbo",
+		Ind: "Indonihyia kasa",
+		Ita: "Italy kasa",
+		Jav: "Gyabanis kasa",
+		Jpn: "Gyapan kasa",
+		Khm: "Kambodia kasa",
+		Kin: "Rewanda kasa",
+		Kor: "Korea kasa",
+		Mya: "Bɛɛmis kasa",
+		Nep: "Nɛpal kasa",
+		Nld: "Dɛɛkye",
+		Pan: "Pungyabi kasa",
+		Pes: "Pɛɛhyia kasa",
+		Pol: "Pɔland kasa",
+		Por: "Pɔɔtugal kasa",
+		Ron: "Romenia kasa",
+		Rus: "Rahyia kasa",
+		Som: "Somalia kasa",
+		Spa: "Spain kasa",
+		Swe: "Sweden kasa",
+		Tam: "Tamil kasa",
+		Tha: "Taeland kasa",
+		Tur: "Tɛɛki kasa",
+		Ukr: "Ukren kasa",
+		Urd: "Urdu kasa",
+		Vie: "Viɛtnam kasa",
+		Yor: "Yoruba",
+		Zul: "Zulu",
+	},
+	Amh: {
+		Afr: "አፍሪካንኛ",
+		Aka: "አካንኛ",
+		Amh: "አማርኛ",
+		Arb: "ዓረብኛ",
+		Azj: "አዘርባጃንኛ",
+		Bel: "ቤላራሻኛ",
+		Ben: "ቤንጋሊኛ",
+		Bho: "ቦጁሪ",
+		Bul: "ቡልጋሪኛ",
+		Ceb: "ካቡዋኖ",
+		Ces: "ቼክኛ",
+		Cmn: "ቻይንኛ",
+		Dan: "ዴኒሽ",
+		Deu: "ጀርመን",
+		Ell: "ግሪክኛ",
+		Eng: "እንግሊዝኛ",
+		Epo: "ኤስፐራንቶ",
+		Est: "ኢስቶኒያንኛ",
+		Fin: "ፊኒሽ",
+		Fra: "ፈረንሳይኛ",
+		Guj: "ጉጃርቲኛ",
+		Hat: "ሃይትኛ",
+		Hau: "ሃውሳኛ",
+		Heb: "ዕብራይስጥ\ufeff",
+		Hin: "ሒንዱኛ",
+		Hrv: "ክሮሽያንኛ",
+		Hun: "ሀንጋሪኛ",
+		Ibo: "ኢግቦኛ",
+		Ilo: "ኢሎኮ",
+		Ind: "ኢንዶኔዥኛ",
+		Ita: "ጣሊያንኛ",
+		Jav: "ጃቫንኛ",
+		Jpn: "ጃፓንኛ",
+		Kan: "ካናዳኛ",
+		Kat: "ጆርጂያን",
+		Khm: "ክህመርኛ",
+		Kin: "ኪንያርዋንድኛ",
+		Kor: "ኮሪያኛ",
+		Kur: "ኩርድሽኛ",
+		Lav: "ላትቪያን",
+		Lit: "ሉቴንያንኛ",
+		Mai: "ማይተሊ",
+		Mal: "ማላያላምኛ",
+		Mar: "ማራቲኛ",
+		Mkd: "ማሴዶንኛ",
+		Mlg: "ማላጋስኛ",
+		Mya: "ቡርማኛ",
+		Nep: "ኔፓሊኛ",
+		Nld: "ደች",
+		Nno: "የኖርዌይ ናይኖርስክ",
+		Nob: "የኖርዌይ ቦክማል",
+		Nya: "ንያንጃ",
+		Ori: "ኦዲያኛ",
+		Orm: "ኦሮሞኛ",
+		Pan: "ፑንጃብኛ",
+		Pes: "ፐርሺያኛ",
+		Pol: "ፖሊሽኛ",
+		Por: "ፖርቹጋልኛ",
+		Ron: "ሮማኒያን",
+		Run: "ሩንዲኛ",
+		Rus: "ራሽያኛ",
+		Sin: "ሲንሃልኛ",
+		Slv: "ስሎቪኛ",
+		Sna: "ሾናኛ",
+		Som: "ሱማልኛ",
+		Spa: "ስፓንሽኛ",
+		Srp: "ሰርቢኛ",
+		Swe: "ስዊድንኛ",
+		Tam: "ታሚልኛ",
+		Tel: "ተሉጉኛ",
+		Tgl: "ፊሊፒንኛ",
+		Tha: "ታይኛ",
+		Tir: "ትግርኛ",
+		Tuk: "ቱርክሜንኛ",
+		Tur: "ቱርክኛ",
+		Uig: "ኡዊግሁርኛ",
+		Ukr: "ዩክሬንኛ",
+		Urd: "ኡርዱኛ",
+		Uzb: "ኡዝቤክኛ",
+		Vie: "ቪየትናምኛ",
+		Ydd: "ይዲሽኛ",
+		Yor: "ዮሩባዊኛ",
+		Zul: "ዙሉኛ",
+	},
+	Arb: {
+		Afr: "الأفريقانية",
+		Aka: "الأكانية",
+		Amh: "الأمهرية",
+		Arb: "العربية",
+		Azj: "الأذربيجانية",
+		Bel: "البيلاروسية",
+		Ben: "البنغالية",
+		Bho: "البهوجبورية",
+		Bul: "البلغارية",
+		Ceb: "السيبونية",
+		Ces: "التشيكية",
+		Cmn: "الصينية",
+		Dan: "الدانمركية",
+		Deu: "الألمانية",
+		Ell: "اليونانية",
+		Eng: "الإنجليزية",
+		Epo: "الإسبرانتو",
+		Est: "الإستونية",
+		Fin: "الفنلندية",
+		Fra: "الفرنسية",
+		Guj: "الغوجاراتية",
+		Hat: "الكريولية الهايتية",
+		Hau: "الهوسا",
+		Heb: "العبرية",
+		Hin: "الهندية",
+		Hrv: "الكرواتية",
+		Hun: "الهنغارية",
+		Ibo: "الإيجبو",
+		Ilo: "الإيلوكو",
+		Ind: "الإندونيسية",
+		Ita: "الإيطالية",
+		Jav: "الجاوية",
+		Jpn: "اليابانية",
+		Kan: "الكانادا",
+		Kat: "الجورجية",
+		Khm: "الخميرية",
+		Kin: "الكينيارواندا",
+		Kor: "الكورية",
+		Kur: "الكردية",
+		Lav: "اللاتفية",
+		Lit: "الليتوانية",
+		Mai: "المايثيلي",
+		Mal: "المالايالامية",
+		Mar: "الماراثية",
+		Mkd: "المقدونية",
+		Mlg: "المالاغاشية",
+		Mya: "البورمية",
+		Nep: "النيبالية",
+		Nld: "الهولندية",
+		Nno: "النرويجية نينورسك",
+		Nob: "بوكمول النرويجية",
+		Nya: "النيانجا",
+		Ori: "الأورية",
+		Orm: "الأورومية",
+		Pan: "البنجابية",
+		Pes: "الفارسية",
+		Pol: "البولندية",
+		Por: "البرتغالية",
+		Ron: "الرومانية",
+		Run: "الرندي",
+		Rus: "الروسية",
+		Sin: "السنهالية",
+		Slv: "السلوفانية",
+		Sna: "الشونا",
+		Som: "الصومالية",
+		Spa: "الإسبانية",
+		Srp: "الصربية",
+		Swe: "السويدية",
+		Tam: "التاميلية",
+		Tel: "التيلوغوية",
+		Tgl: "الفلبينية",
+		Tha: "التايلاندية",
+		Tir: "التغرينية",
+		Tuk: "التركمانية",
+		Tur: "التركية",
+		Uig: "الأويغورية",
+		Ukr: "الأوكرانية",
+		Urd: "الأوردية",
+		Uzb: "الأوزبكية",
+		Vie: "الفيتنامية",
+		Ydd: "اليديشية",
+		Yor: "اليوروبا",
+		Zul: "الزولو",
+	},
+	Azj: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amhar",
+		Arb: "ərəb",
+		Azj: "azərbaycan",
+		Bel: "belarus",
+		Ben: "benqal",
+		Bho: "bxoçpuri",
+		Bul: "bolqar",
+		Ceb: "sebuan",
+		Ces: "çex",
+		Cmn: "çin",
+		Dan: "danimarka",
+		Deu: "alman",
+		Ell: "yunan",
+		Eng: "ingilis",
+		Epo: "esperanto",
+		Est: "eston",
+		Fin: "fin",
+		Fra: "fransız",
+		Guj: "qucarat",
+		Hat: "haiti kreol",
+		Hau: "hausa",
+		Heb: "ivrit",
+		Hin: "hind",
+		Hrv: "xorvat",
+		Hun: "macar",
+		Ibo: "iqbo",
+		Ilo: "iloko",
+		Ind: "indoneziya",
+		Ita: "italyan",
+		Jav: "yava",
+		Jpn: "yapon",
+		Kan: "kannada",
+		Kat: "gürcü",
+		Khm: "kxmer",
+		Kin: "kinyarvanda",
+		Kor: "koreya",
+		Kur: "kürd",
+		Lav: "latış",
+		Lit: "litva",
+		Mai: "maitili",
+		Mal: "malayalam",
+		Mar: "marathi",
+		Mkd: "makedon",
+		Mlg: "malaqas",
+		Mya: "birman",
+		Nep: "nepal",
+		Nld: "holland",
+		Nno: "nünorsk norveç",
+		Nob: "bokmal norveç",
+		Nya: "nyanca",
+		Ori: "odiya",
+		Orm: "oromo",
+		Pan: "pəncab",
+		Pes: "fars",
+		Pol: "polyak",
+		Por: "portuqal",
+		Ron: "rumın",
+		Run: "rundi",
+		Rus: "rus",
+		Sin: "sinhala",
+		Slv: "sloven",
+		Sna: "şona",
+		Som: "somali",
+		Spa: "ispan",
+		Srp: "serb",
+		Swe: "isveç",
+		Tam: "tamil",
+		Tel: "teluqu",
+		Tgl: "filippin",
+		Tha: "tay",
+		Tir: "tiqrin",
+		Tuk: "türkmən",
+		Tur: "türk",
+		Uig: "uyğur",
+		Ukr: "ukrayna",
+		Urd: "urdu",
+		Uzb: "özbək",
+		Vie: "vyetnam",
+		Ydd: "idiş",
+		Yor: "yoruba",
+		Zul: "zulu",
+	},
+	Bel: {
+		Afr: "афрыкаанс",
+		Aka: "акан",
+		Amh: "амхарская",
+		Arb: "арабская",
+		Azj: "азербайджанская",
+		Bel: "беларуская",
+		Ben: "бенгальская",
+		Bho: "бхаджпуры",
+		Bul: "балгарская",
+		Ceb: "себуана",
+		Ces: "чэшская",
+		Cmn: "кітайская",
+		Dan: "дацкая",
+		Deu: "нямецкая",
+		Ell: "грэчаская",
+		Eng: "англійская",
+		Epo: "эсперанта",
+		Est: "эстонская",
+		Fin: "фінская",
+		Fra: "французская",
+		Guj: "гуджараці",
+		Hat: "гаіцянская крэольская",
+		Hau: "хауса",
+		Heb: "іўрыт",
+		Hin: "хіндзі",
+		Hrv: "харвацкая",
+		Hun: "венгерская",
+		Ibo: "ігба",
+		Ilo: "ілакана",
+		Ind: "інданезійская",
+		Ita: "італьянская",
+		Jav: "яванская",
+		Jpn: "японская",
+		Kan: "канада",
+		Kat: "грузінская",
+		Khm: "кхмерская",
+		Kin: "руанда",
+		Kor: "карэйская",
+		Kur: "курдская",
+		Lav: "латышская",
+		Lit: "літоўская",
+		Mai: "майтхілі",
+		Mal: "малаялам",
+		Mar: "маратхі",
+		Mkd: "македонская",
+		Mlg: "малагасійская",
+		Mya: "бірманская",
+		Nep: "непальская",
+		Nld: "нідэрландская",
+		Nno: "нарвежская (нюношк)",
+		Nob: "нарвежская (букмол)",
+		Nya: "ньянджа",
+		Ori: "орыя",
+		Orm: "арома",
+		Pan: "панджабі",
+		Pes: "фарсі",
+		Pol: "польская",
+		Por: "партугальская",
+		Ron: "румынская",
+		Run: "рундзі",
+		Rus: "руская",
+		Sin: "сінгальская",
+		Slv: "славенская",
+		Sna: "шона",
+		Som: "самалі",
+		Spa: "іспанская",
+		Srp: "сербская",
+		Swe: "шведская",
+		Tam: "тамільская",
+		Tel: "тэлугу",
+		Tgl: "філіпінская",
+		Tha: "тайская",
+		Tir: "тыгрынья",
+		Tuk: "туркменская",
+		Tur: "турэцкая",
+		Uig: "уйгурская",
+		Ukr: "украінская",
+		Urd: "урду",
+		Uzb: "узбекская",
+		Vie: "в’етнамская",
+		Ydd: "ідыш",
+		Yor: "ёруба",
+		Zul: "зулу",
+	},
+	Ben: {
+		Afr: "আফ্রিকান",
+		Aka: "আকান",
+		Amh: "আমহারিক",
+		Arb: "আরবী",
+		Azj: "আজারবাইজানী",
+		Bel: "বেলারুশিয়",
+		Ben: "বাংলা",
+		Bho: "ভোজপুরি",
+		Bul: "বুলগেরিয়",
+		Ceb: "চেবুয়ানো",
+		Ces: "চেক",
+		Cmn: "চীনা",
+		Dan: "ডেনিশ",
+		Deu: "জার্মান",
+		Ell: "গ্রিক",
+		Eng: "ইংরেজি",
+		Epo: "এস্পেরান্তো",
+		Est: "এস্তোনীয়",
+		Fin: "ফিনিশ",
+		Fra: "ফরাসি",
+		Guj: "গুজরাটি",
+		Hat: "হাইতিয়ান ক্রেওল",
+		Hau: "হাউসা",
+		Heb: "হিব্রু",
+		Hin: "হিন্দি",
+		Hrv: "ক্রোয়েশীয়",
+		Hun: "হাঙ্গেরীয়",
+		Ibo: "ইগ্\u200cবো",
+		Ilo: "ইলোকো",
+		Ind: "ইন্দোনেশীয়",
+		Ita: "ইতালিয়",
+		Jav: "জাভানিজ",
+		Jpn: "জাপানি",
+		Kan: "কন্নড়",
+		Kat: "জর্জিয়ান",
+		Khm: "খমের",
+		Kin: "কিনয়ারোয়ান্ডা",
+		Kor: "কোরিয়ান",
+		Kur: "কুর্দিশ",
+		Lav: "লাত্\u200cভীয়",
+		Lit: "লিথুয়েনীয়",
+		Mai: "মৈথিলি",
+		Mal: "মালায়ালাম",
+		Mar: "মারাঠি",
+		Mkd: "ম্যাসিডোনীয়",
+		Mlg: "মালাগাসি",
+		Mya: "বর্মি",
+		Nep: "নেপালী",
+		Nld: "ডাচ",
+		Nno: "নরওয়েজীয়ান নিনর্স্ক",
+		Nob: "নরওয়েজিয়ান বোকমাল",
+		Nya: "নায়াঞ্জা",
+		Ori: "ওড়িয়া",
+		Orm: "অরোমো",
+		Pan: "পাঞ্জাবী",
+		Pes: "ফার্সি",
+		Pol: "পোলিশ",
+		Por: "পর্তুগীজ",
+		Ron: "রোমানীয়",
+		Run: "রুন্দি",
+		Rus: "রুশ",
+		Sin: "সিংহলী",
+		Slv: "স্লোভেনীয়",
+		Sna: "শোনা",
+		Som: "সোমালি",
+		Spa: "স্প্যানিশ",
+		Srp: "সার্বীয়",
+		Swe: "সুইডিশ",
+		Tam: "তামিল",
+		Tel: "তেলেগু",
+		Tgl: "ফিলিপিনো",
+		Tha: "থাই",
+		Tir: "তিগরিনিয়া",
+		Tuk: "তুর্কমেনী",
+		Tur: "তুর্কী",
+		Uig: "উইঘুর",
+		Ukr: "ইউক্রেনীয়",
+		Urd: "উর্দু",
+		Uzb: "উজবেকীয়",
+		Vie: "ভিয়েতনামী",
+		Ydd: "ইয়েদ্দিশ",
+		Yor: "ইওরুবা",
+		Zul: "জুলু",
+	},
+	Bul: {
+		Afr: "африканс",
+		Aka: "акан",
+		Amh: "амхарски",
+		Arb: "арабски",
+		Azj: "азербайджански",
+		Bel: "беларуски",
+		Ben: "бенгалски",
+		Bho: "божпури",
+		Bul: "български",
+		Ceb: "себуански",
+		Ces: "чешки",
+		Cmn: "китайски",
+		Dan: "датски",
+		Deu: "немски",
+		Ell: "гръцки",
+		Eng: "английски",
+		Epo: "есперанто",
+		Est: "естонски",
+		Fin: "фински",
+		Fra: "френски",
+		Guj: "гуджарати",
+		Hat: "хаитянски креолски",
+		Hau: "хауса",
+		Heb: "иврит",
+		Hin: "хинди",
+		Hrv: "хърватски",
+		Hun: "унгарски",
+		Ibo: "игбо",
+		Ilo: "илоко",
+		Ind: "индонезийски",
+		Ita: "италиански",
+		Jav: "явански",
+		Jpn: "японски",
+		Kan: "каннада",
+		Kat: "грузински",
+		Khm: "кхмерски",
+		Kin: "киняруанда",
+		Kor: "корейски",
+		Kur: "кюрдски",
+		Lav: "латвийски",
+		Lit: "литовски",
+		Mai: "майтхили",
+		Mal: "малаялам",
+		Mar: "марати",
+		Mkd: "македонски",
+		Mlg: "малгашки",
+		Mya: "бирмански",
+		Nep: "непалски",
+		Nld: "нидерландски",
+		Nno: "норвежки (нюношк)",
+		Nob: "норвежки (букмол)",
+		Nya: "нянджа",
+		Ori: "ория",
+		Orm: "оромо",
+		Pan: "пенджабски",
+		Pes: "персийски",
+		Pol: "полски",
+		Por: "португалски",
+		Ron: "румънски",
+		Run: "рунди",
+		Rus: "руски",
+		Sin: "синхалски",
+		Slv: "словенски",
+		Sna: "шона",
+		Som: "сомалийски",
+		Spa: "испански",
+		Srp: "сръбски",
+		Swe: "шведски",
+		Tam: "тамилски",
+		Tel: "телугу",
+		Tgl: "филипински",
+		Tha: "тайски",
+		Tir: "тигриня",
+		Tuk: "туркменски",
+		Tur: "турски",
+		Uig: "уйгурски",
+		Ukr: "украински",
+		Urd: "урду",
+		Uzb: "узбекски",
+		Vie: "виетнамски",
+		Ydd: "идиш",
+		Yor: "йоруба",
+		Zul: "зулуски",
+	},
+	Ces: {
+		Afr: "afrikánština",
+		Aka: "akanština",
+		Amh: "amharština",
+		Arb: "arabština",
+		Azj: "ázerbájdžánština",
+		Bel: "běloruština",
+		Ben: "bengálština",
+		Bho: "bhódžpurština",
+		Bul: "bulharština",
+		Ceb: "cebuánština",
+		Ces: "čeština",
+		Cmn: "čínština",
+		Dan: "dánština",
+		Deu: "němčina",
+		Ell: "řečtina",
+		Eng: "angličtina",
+		Epo: "esperanto",
+		Est: "estonština",
+		Fin: "finština",
+		Fra: "francouzština",
+		Guj: "gudžarátština",
+		Hat: "haitština",
+		Hau: "hauština",
+		Heb: "hebrejština",
+		Hin: "hindština",
+		Hrv: "chorvatština",
+		Hun: "maďarština",
+		Ibo: "igboština",
+		Ilo: "ilokánština",
+		Ind: "indonéština",
+		Ita: "italština",
+		Jav: "javánština",
+		Jpn: "japonština",
+		Kan: "kannadština",
+		Kat: "gruzínština",
+		Khm: "khmérština",
+		Kin: "kiňarwandština",
+		Kor: "korejština",
+		Kur: "kurdština",
+		Lav: "lotyština",
+		Lit: "litevština",
+		Mai: "maithiliština",
+		Mal: "malajálamština",
+		Mar: "maráthština",
+		Mkd: "makedonština",
+		Mlg: "malgaština",
+		Mya: "barmština",
+		Nep: "nepálština",
+		Nld: "nizozemština",
+		Nno: "norština (nynorsk)",
+		Nob: "norština (bokmål)",
+		Nya: "ňandžština",
+		Ori: "urijština",
+		Orm: "oromština",
+		Pan: "paňdžábština",
+		Pes: "perština",
+		Pol: "polština",
+		Por: "portugalština",
+		Ron: "rumunština",
+		Run: "kirundština",
+		Rus: "ruština",
+		Sin: "sinhálština",
+		Slv: "slovinština",
+		Sna: "šonština",
+		Som: "somálština",
+		Spa: "španělština",
+		Srp: "srbština",
+		Swe: "švédština",
+		Tam: "tamilština",
+		Tel: "telugština",
+		Tgl: "filipínština",
+		Tha: "thajština",
+		Tir: "tigrinijština",
+		Tuk: "turkmenština",
+		Tur: "turečtina",
+		Uig: "ujgurština",
+		Ukr: "ukrajinština",
+		Urd: "urdština",
+		Uzb: "uzbečtina",
+		Vie: "vietnamština",
+		Ydd: "jidiš",
+		Yor: "jorubština",
+		Zul: "zuluština",
+	},
+	Cmn: {
+		Afr: "南非荷兰语",
+		Aka: "阿肯语",
+		Amh: "阿姆哈拉语",
+		Arb: "阿拉伯语",
+		Azj: "阿塞拜疆语",
+		Bel: "白俄罗斯语",
+		Ben: "孟加拉语",
+		Bho: "博杰普尔语",
+		Bul: "保加利亚语",
+		Ceb: "宿务语",
+		Ces: "捷克语",
+		Cmn: "中文",
+		Dan: "丹麦语",
+		Deu: "德语",
+		Ell: "希腊语",
+		Eng: "英语",
+		Epo: "世界语",
+		Est: "爱沙尼亚语",
+		Fin: "芬兰语",
+		Fra: "法语",
+		Guj: "古吉拉特语",
+		Hat: "海地克里奥尔语",
+		Hau: "豪萨语",
+		Heb: "希伯来语",
+		Hin: "印地语",
+		Hrv: "克罗地亚语",
+		Hun: "匈牙利语",
+		Ibo: "伊博语",
+		Ilo: "伊洛卡诺语",
+		Ind: "印度尼西亚语",
+		Ita: "意大利语",
+		Jav: "爪哇语",
+		Jpn: "日语",
+		Kan: "卡纳达语",
+		Kat: "格鲁吉亚语",
+		Khm: "高棉语",
+		Kin: "卢旺达语",
+		Kor: "韩语",
+		Kur: "库尔德语",
+		Lav: "拉脱维亚语",
+		Lit: "立陶宛语",
+		Mai: "迈蒂利语",
+		Mal: "马拉雅拉姆语",
+		Mar: "马拉地语",
+		Mkd: "马其顿语",
+		Mlg: "马拉加斯语",
+		Mya: "缅甸语",
+		Nep: "尼泊尔语",
+		Nld: "荷兰语",
+		Nno: "挪威尼诺斯克语",
+		Nob: "书面挪威语",
+		Nya: "齐切瓦语",
+		Ori: "奥里亚语",
+		Orm: "奥罗莫语",
+		Pan: "旁遮普语",
+		Pes: "波斯语",
+		Pol: "波兰语",
+		Por: "葡萄牙语",
+		Ron: "罗马尼亚语",
+		Run: "隆迪语",
+		Rus: "俄语",
+		Sin: "僧伽罗语",
+		Slv: "斯洛文尼亚语",
+		Sna: "绍纳语",
+		Som: "索马里语",
+		Spa: "西班牙语",
+		Srp: "塞尔维亚语",
+		Swe: "瑞典语",
+		Tam: "泰米尔语",
+		Tel: "泰卢固语",
+		Tgl: "菲律宾语",
+		Tha: "泰语",
+		Tir: "提格利尼亚语",
+		Tuk: "土库曼语",
+		Tur: "土耳其语",
+		Uig: "维吾尔语",
+		Ukr: "乌克兰语",
+		Urd: "乌尔都语",
+		Uzb: "乌兹别克语",
+		Vie: "越南语",
+		Ydd: "意第绪语",
+		Yor: "约鲁巴语",
+		Zul: "祖鲁语",
+	},
+	Dan: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amharisk",
+		Arb: "arabisk",
+		Azj: "aserbajdsjansk",
+		Bel: "hviderussisk",
+		Ben: "bengali",
+		Bho: "bhojpuri",
+		Bul: "bulgarsk",
+		Ceb: "cebuano",
+		Ces: "tjekkisk",
+		Cmn: "kinesisk",
+		Dan: "dansk",
+		Deu: "tysk",
+		Ell: "græsk",
+		Eng: "engelsk",
+		Epo: "esperanto",
+		Est: "estisk",
+		Fin: "finsk",
+		Fra: "fransk",
+		Guj: "gujarati",
+		Hat: "haitisk",
+		Hau: "hausa",
+		Heb: "hebraisk",
+		Hin: "hindi",
+		Hrv: "kroatisk",
+		Hun: "ungarsk",
+		Ibo: "igbo",
+		Ilo: "iloko",
+		Ind: "indonesisk",
+		Ita: "italiensk",
+		Jav: "javanesisk",
+		Jpn: "japansk",
+		Kan: "kannada",
+		Kat: "georgisk",
+		Khm: "khmer",
+		Kin: "kinyarwanda",
+		Kor: "koreansk",
+		Kur: "kurdisk",
+		Lav: "lettisk",
+		Lit: "litauisk",
+		Mai: "maithili",
+		Mal: "malayalam",
+		Mar: "marathisk",
+		Mkd: "makedonsk",
+		Mlg: "malagassisk",
+		Mya: "burmesisk",
+		Nep: "nepalesisk",
+		Nld: "hollandsk",
+		Nno: "nynorsk",
+		Nob: "norsk bokmål",
+		Nya: "nyanja",
+		Ori: "oriya",
+		Orm: "oromo",
+		Pan: "punjabisk",
+		Pes: "persisk",
+		Pol: "polsk",
+		Por: "portugisisk",
+		Ron: "rumænsk",
+		Run: "rundi",
+		Rus: "russisk",
+		Sin: "singalesisk",
+		Slv: "slovensk",
+		Sna: "shona",
+		Som: "somali",
+		Spa: "spansk",
+		Srp: "serbisk",
+		Swe: "svensk",
+		Tam: "tamil",
+		Tel: "telugu",
+		Tgl: "filippinsk",
+		Tha: "thai",
+		Tir: "tigrinya",
+		Tuk: "turkmensk",
+		Tur: "tyrkisk",
+		Uig: "uygurisk",
+		Ukr: "ukrainsk",
+		Urd: "urdu",
+		Uzb: "usbekisk",
+		Vie: "vietnamesisk",
+		Ydd: "jiddisch",
+		Yor: "yoruba",
+		Zul: "zulu",
+	},
+	Deu: {
+		Afr: "Afrikaans",
+		Aka: "Akan",
+		Amh: "Amharisch",
+		Arb: "Arabisch",
+		Azj: "Aserbaidschanisch",
+		Bel: "Weißrussisch",
+		Ben: "Bengalisch",
+		Bho: "Bhodschpuri",
+		Bul: "Bulgarisch",
+		Ceb: "Cebuano",
+		Ces: "Tschechisch",
+		Cmn: "Chinesisch",
+		Dan: "Dänisch",
+		Deu: "Deutsch",
+		Ell: "Griechisch",
+		Eng: "Englisch",
+		Epo: "Esperanto",
+		Est: "Estnisch",
+		Fin: "Finnisch",
+		Fra: "Französisch",
+		Guj: "Gujarati",
+		Hat: "Haiti-Kreolisch",
+		Hau: "Haussa",
+		Heb: "Hebräisch",
+		Hin: "Hindi",
+		Hrv: "Kroatisch",
+		Hun: "Ungarisch",
+		Ibo: "Igbo",
+		Ilo: "Ilokano",
+		Ind: "Indonesisch",
+		Ita: "Italienisch",
+		Jav: "Javanisch",
+		Jpn: "Japanisch",
+		Kan: "Kannada",
+		Kat: "Georgisch",
+		Khm: "Khmer",
+		Kin: "Kinyarwanda",
+		Kor: "Koreanisch",
+		Kur: "Kurdisch",
+		Lav: "Lettisch",
+		Lit: "Litauisch",
+		Mai: "Maithili",
+		Mal: "Malayalam",
+		Mar: "Marathi",
+		Mkd: "Mazedonisch",
+		Mlg: "Madagassisch",
+		Mya: "Birmanisch",
+		Nep: "Nepalesisch",
+		Nld: "Niederländisch",
+		Nno: "Norwegisch Nynorsk",
+		Nob: "Norwegisch Bokmål",
+		Nya: "Nyanja",
+		Ori: "Oriya",
+		Orm: "Oromo",
+		Pan: "Punjabi",
+		Pes: "Persisch",
+		Pol: "Polnisch",
+		Por: "Portugiesisch",
+		Ron: "Rumänisch",
+		Run: "Rundi",
+		Rus: "Russisch",
+		Sin: "Singhalesisch",
+		Slv: "Slowenisch",
+		Sna: "Shona",
+		Som: "Somali",
+		Spa: "Spanisch",
+		Srp: "Serbisch",
+		Swe: "Schwedisch",
+		Tam: "Tamil",
+		Tel: "Telugu",
+		Tgl: "Filipino",
+		Tha: "Thailändisch",
+		Tir: "Tigrinya",
+		Tuk: "Turkmenisch",
+		Tur: "Türkisch",
+		Uig: "Uigurisch",
+		Ukr: "Ukrainisch",
+		Urd: "Urdu",
+		Uzb: "Usbekisch",
+		Vie: "Vietnamesisch",
+		Ydd: "Jiddisch",
+		Yor: "Yoruba",
+		Zul: "Zulu",
+	},
+	Ell: {
+		Afr: "Αφρικάανς",
+		Aka: "Ακάν",
+		Amh: "Αμχαρικά",
+		Arb: "Αραβικά",
+		Azj: "Αζερμπαϊτζανικά",
+		Bel: "Λευκορωσικά",
+		Ben: "Βεγγαλικά",
+		Bho: "Μποζπούρι",
+		Bul: "Βουλγαρικά",
+		Ceb: "Σεμπουάνο",
+		Ces: "Τσεχικά",
+		Cmn: "Κινεζικά",
+		Dan: "Δανικά",
+		Deu: "Γερμανικά",
+		Ell: "Ελληνικά",
+		Eng: "Αγγλικά",
+		Epo: "Εσπεράντο",
+		Est: "Εσθονικά",
+		Fin: "Φινλανδικά",
+		Fra: "Γαλλικά",
+		Guj: "Γκουγιαράτι",
+		Hat: "Αϊτιανά",
+		Hau: "Χάουσα",
+		Heb: "Εβραϊκά",
+		Hin: "Χίντι",
+		Hrv: "Κροατικά",
+		Hun: "Ουγγρικά",
+		Ibo: "Ίγκμπο",
+		Ilo: "Ιλόκο",
+		Ind: "Ινδονησιακά",
+		Ita: "Ιταλικά",
+		Jav: "Ιαβανικά",
+		Jpn: "Ιαπωνικά",
+		Kan: "Κανάντα",
+		Kat: "Γεωργιανά",
+		Khm: "Χμερ",
+		Kin: "Κινιαρουάντα",
+		Kor: "Κορεατικά",
+		Kur: "Κουρδικά",
+		Lav: "Λετονικά",
+		Lit: "Λιθουανικά",
+		Mai: "Μαϊτχίλι",
+		Mal: "Μαλαγιαλαμικά",
+		Mar: "Μαραθικά",
+		Mkd: "Σλαβομακεδονικά",
+		Mlg: "Μαλγασικά",
+		Mya: "Βιρμανικά",
+		Nep: "Νεπαλικά",
+		Nld: "Ολλανδικά",
+		Nno: "Νορβηγικά Νινόρσκ",
+		Nob: "Νορβηγικά Μποκμάλ",
+		Nya: "Νιάντζα",
+		Ori: "Όντια",
+		Orm: "Ορόμο",
+		Pan: "Παντζαπικά",
+		Pes: "Περσικά",
+		Pol: "Πολωνικά",
+		Por: "Πορτογαλικά",
+		Ron: "Ρουμανικά",
+		Run: "Ρούντι",
+		Rus: "Ρωσικά",
+		Sin: "Σινχαλεζικά",
+		Slv: "Σλοβενικά",
+		Sna: "Σόνα",
+		Som: "Σομαλικά",
+		Spa: "Ισπανικά",
+		Srp: "Σερβικά",
+		Swe: "Σουηδικά",
+		Tam: "Ταμιλικά",
+		Tel: "Τελούγκου",
+		Tgl: "Φιλιππινικά",
+		Tha: "Ταϊλανδικά",
+		Tir: "Τιγκρινικά",
+		Tuk: "Τουρκμενικά",
+		Tur: "Τουρκικά",
+		Uig: "Ουιγκουρικά",
+		Ukr: "Ουκρανικά",
+		Urd: "Ουρντού",
+		Uzb: "Ουζμπεκικά",
+		Vie: "Βιετναμικά",
+		Ydd: "Γίντις",
+		Yor: "Γιορούμπα",
+		Zul: "Ζουλού",
+	},
+	Epo: {
+		Afr: "afrikansa",
+		Aka: "tw",
+		Amh: "amhara",
+		Arb: "araba",
+		Azj: "azerbajĝana",
+		Bel: "belorusa",
+		Ben: "bengala",
+		Bul: "bulgara",
+		Ces: "ĉeĥa",
+		Cmn: "ĉina",
+		Dan: "dana",
+		Deu: "germana",
+		Ell: "greka",
+		Eng: "angla",
+		Epo: "esperanto",
+		Est: "estona",
+		Fin: "finna",
+		Fra: "franca",
+		Guj: "guĝarata",
+		Hat: "haitia kreola",
+		Hau: "haŭsa",
+		Heb: "hebrea",
+		Hin: "hinda",
+		Hrv: "kroata",
+		Hun: "hungara",
+		Ind: "indonezia",
+		Ita: "itala",
+		Jav: "java",
+		Jpn: "japana",
+		Kan: "kanara",
+		Kat: "kartvela",
+		Khm: "kmera",
+		Kin: "ruanda",
+		Kor: "korea",
+		Kur: "kurda",
+		Lav: "latva",
+		Lit: "litova",
+		Mal: "malajalama",
+		Mar: "marata",
+		Mkd: "makedona",
+		Mlg: "malagasa",
+		Mya: "birma",
+		Nep: "nepala",
+		Nld: "nederlanda",
+		Nno: "novnorvega",
+		Nob: "dannorvega",
+		Ori: "orijo",
+		Orm: "oroma",
+		Pan: "panĝaba",
+		Pes: "persa",
+		Pol: "pola",
+		Por: "portugala",
+		Ron: "rumana",
+		Run: "burunda",
+		Rus: "rusa",
+		Sin: "sinhala",
+		Slv: "slovena",
+		Sna: "ŝona",
+		Som: "somala",
+		Spa: "hispana",
+		Srp: "serba",
+		Swe: "sveda",
+		Tam: "tamila",
+		Tel: "telugua",
+		Tgl: "filipina",
+		Tha: "taja",
+		Tir: "tigraja",
+		Tuk: "turkmena",
+		Tur: "turka",
+		Uig: "ujgura",
+		Ukr: "ukraina",
+		Urd: "urduo",
+		Uzb: "uzbeka",
+		Vie: "vjetnama",
+		Ydd: "jida",
+		Yor: "joruba",
+		Zul: "zulua",
+	},
+	Est: {
+		Afr: "afrikaani",
+		Aka: "akani",
+		Amh: "amhara",
+		Arb: "araabia",
+		Azj: "aserbaidžaani",
+		Bel: "valgevene",
+		Ben: "bengali",
+		Bho: "bhodžpuri",
+		Bul: "bulgaaria",
+		Ceb: "sebu",
+		Ces: "tšehhi",
+		Cmn: "hiina",
+		Dan: "taani",
+		Deu: "saksa",
+		Ell: "kreeka",
+		Eng: "inglise",
+		Epo: "esperanto",
+		Est: "eesti",
+		Fin: "soome",
+		Fra: "prantsuse",
+		Guj: "gudžarati",
+		Hat: "haiti",
+		Hau: "hausa",
+		Heb: "heebrea",
+		Hin: "hindi",
+		Hrv: "horvaadi",
+		Hun: "ungari",
+		Ibo: "ibo",
+		Ilo: "iloko",
+		Ind: "indoneesia",
+		Ita: "itaalia",
+		Jav: "jaava",
+		Jpn: "jaapani",
+		Kan: "kannada",
+		Kat: "gruusia",
+		Khm: "khmeeri",
+		Kin: "ruanda",
+		Kor: "korea",
+		Kur: "kurdi",
+		Lav: "läti",
+		Lit: "leedu",
+		Mai: "maithili",
+		Mal: "malajalami",
+		Mar: "marathi",
+		Mkd: "makedoonia",
+		Mlg: "malagassi",
+		Mya: "birma",
+		Nep: "nepali",
+		Nld: "hollandi",
+		Nno: "uusnorra",
+		Nob: "norra bokmål",
+		Nya: "njandža",
+		Ori: "oria",
+		Orm: "oromo",
+		Pan: "pandžabi",
+		Pes: "pärsia",
+		Pol: "poola",
+		Por: "portugali",
+		Ron: "rumeenia",
+		Run: "rundi",
+		Rus: "vene",
+		Sin: "singali",
+		Slv: "sloveeni",
+		Sna: "šona",
+		Som: "somaali",
+		Spa: "hispaania",
+		Srp: "serbia",
+		Swe: "rootsi",
+		Tam: "tamili",
+		Tel: "telugu",
+		Tgl: "filipiini",
+		Tha: "tai",
+		Tir: "tigrinja",
+		Tuk: "türkmeeni",
+		Tur: "türgi",
+		Uig: "uiguuri",
+		Ukr: "ukraina",
+		Urd: "urdu",
+		Uzb: "usbeki",
+		Vie: "vietnami",
+		Ydd: "jidiši",
+		Yor: "joruba",
+		Zul: "suulu",
+	},
+	Fin: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amhara",
+		Arb: "arabia",
+		Azj: "azeri",
+		Bel: "valkovenäjä",
+		Ben: "bengali",
+		Bho: "bhodžpuri",
+		Bul: "bulgaria",
+		Ceb: "cebuano",
+		Ces: "tšekki",
+		Cmn: "kiina",
+		Dan: "tanska",
+		Deu: "saksa",
+		Ell: "kreikka",
+		Eng: "englanti",
+		Epo: "esperanto",
+		Est: "viro",
+		Fin: "suomi",
+		Fra: "ranska",
+		Guj: "gudžarati",
+		Hat: "haiti",
+		Hau: "hausa",
+		Heb: "heprea",
+		Hin: "hindi",
+		Hrv: "kroatia",
+		Hun: "unkari",
+		Ibo: "igbo",
+		Ilo: "iloko",
+		Ind: "indonesia",
+		Ita: "italia",
+		Jav: "jaava",
+		Jpn: "japani",
+		Kan: "kannada",
+		Kat: "georgia",
+		Khm: "khmer",
+		Kin: "ruanda",
+		Kor: "korea",
+		Kur: "kurdi",
+		Lav: "latvia",
+		Lit: "liettua",
+		Mai: "maithili",
+		Mal: "malajalam",
+		Mar: "marathi",
+		Mkd: "makedonia",
+		Mlg: "malagassi",
+		Mya: "burma",
+		Nep: "nepali",
+		Nld: "hollanti",
+		Nno: "norjan nynorsk",
+		Nob: "norjan bokmål",
+		Nya: "njandža",
+		Ori: "orija",
+		Orm: "oromo",
+		Pan: "pandžabi",
+		Pes: "persia",
+		Pol: "puola",
+		Por: "portugali",
+		Ron: "romania",
+		Run: "rundi",
+		Rus: "venäjä",
+		Sin: "sinhala",
+		Slv: "sloveeni",
+		Sna: "šona",
+		Som: "somali",
+		Spa: "espanja",
+		Srp: "serbia",
+		Swe: "ruotsi",
+		Tam: "tamili",
+		Tel: "telugu",
+		Tgl: "filipino",
+		Tha: "thai",
+		Tir: "tigrinja",
+		Tuk: "turkmeeni",
+		Tur: "turkki",
+		Uig: "uiguuri",
+		Ukr: "ukraina",
+		Urd: "urdu",
+		Uzb: "uzbekki",
+		Vie: "vietnam",
+		Ydd: "jiddiš",
+		Yor: "joruba",
+		Zul: "zulu",
+	},
+	Fra: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amharique",
+		Arb: "arabe",
+		Azj: "azéri",
+		Bel: "biélorusse",
+		Ben: "bengali",
+		Bho: "bhojpuri",
+		Bul: "bulgare",
+		Ceb: "cebuano",
+		Ces: "tchèque",
+		Cmn: "chinois",
+		Dan: "danois",
+		Deu: "allemand",
+		Ell: "grec",
+		Eng: "anglais",
+		Epo: "espéranto",
+		Est: "estonien",
+		Fin: "finnois",
+		Fra: "français",
+		Guj: "goudjerati",
+		Hat: "créole haïtien",
+		Hau: "haoussa",
+		Heb: "hébreu",
+		Hin: "hindi",
+		Hrv: "croate",
+		Hun: "hongrois",
+		Ibo: "igbo",
+		Ilo: "ilokano",
+		Ind: "indonésien",
+		Ita: "italien",
+		Jav: "javanais",
+		Jpn: "japonais",
+		Kan: "kannada",
+		Kat: "géorgien",
+		Khm: "khmer",
+		Kin: "rwanda",
+		Kor: "coréen",
+		Kur: "kurde",
+		Lav: "letton",
+		Lit: "lituanien",
+		Mai: "maithili",
+		Mal: "malayalam",
+		Mar: "marathe",
+		Mkd: "macédonien",
+		Mlg: "malgache",
+		Mya: "birman",
+		Nep: "népalais",
+		Nld: "néerlandais",
+		Nno: "norvégien nynorsk",
+		Nob: "norvégien bokmål",
+		Nya: "nyanja",
+		Ori: "oriya",
+		Orm: "oromo",
+		Pan: "pendjabi",
+		Pes: "persan",
+		Pol: "polonais",
+		Por: "portugais",
+		Ron: "roumain",
+		Run: "roundi",
+		Rus: "russe",
+		Sin: "cinghalais",
+		Slv: "slovène",
+		Sna: "shona",
+		Som: "somali",
+		Spa: "espagnol",
+		Srp: "serbe",
+		Swe: "suédois",
+		Tam: "tamoul",
+		Tel: "télougou",
+		Tgl: "filipino",
+		Tha: "thaï",
+		Tir: "tigrigna",
+		Tuk: "turkmène",
+		Tur: "turc",
+		Uig: "ouïghour",
+		Ukr: "ukrainien",
+		Urd: "ourdou",
+		Uzb: "ouzbek",
+		Vie: "vietnamien",
+		Ydd: "yiddish",
+		Yor: "yoruba",
+		Zul: "zoulou",
+	},
+	Guj: {
+		Afr: "આફ્રિકન્સ",
+		Aka: "અકાન",
+		Amh: "એમ્હારિક",
+		Arb: "અરબી",
+		Azj: "અઝરબૈજાની",
+		Bel: "બેલારુશિયન",
+		Ben: "બાંગ્લા",
+		Bho: "ભોજપુરી",
+		Bul: "બલ્ગેરિયન",
+		Ceb: "સિબુઆનો",
+		Ces: "ચેક",
+		Cmn: "ચાઇનીઝ",
+		Dan: "ડેનિશ",
+		Deu: "જર્મન",
+		Ell: "ગ્રીક",
+		Eng: "અંગ્રેજી",
+		Epo: "એસ્પેરાન્ટો",
+		Est: "એસ્ટોનિયન",
+		Fin: "ફિનિશ",
+		Fra: "ફ્રેન્ચ",
+		Guj: "ગુજરાતી",
+		Hat: "હૈતિઅન ક્રેઓલે",
+		Hau: "હૌસા",
+		Heb: "હીબ્રુ",
+		Hin: "હિન્દી",
+		Hrv: "ક્રોએશિયન",
+		Hun: "હંગેરિયન",
+		Ibo: "ઇગ્બો",
+		Ilo: "ઇલોકો",
+		Ind: "ઇન્ડોનેશિયન",
+		Ita: "ઇટાલિયન",
+		Jav: "જાવાનીસ",
+		Jpn: "જાપાનીઝ",
+		Kan: "કન્નડ",
+		Kat: "જ્યોર્જિયન",
+		Khm: "ખ્મેર",
+		Kin: "કિન્યારવાન્ડા",
+		Kor: "કોરિયન",
+		Kur: "કુર્દિશ",
+		Lav: "લાતવિયન",
+		Lit: "લિથુઆનિયન",
+		Mai: "મૈથિલી",
+		Mal: "મલયાલમ",
+		Mar: "મરાઠી",
+		Mkd: "મેસેડોનિયન",
+		Mlg: "મલાગસી",
+		Mya: "બર્મીઝ",
+		Nep: "નેપાળી",
+		Nld: "ડચ",
+		Nno: "નોર્વેજિયન નાયનૉર્સ્ક",
+		Nob: "નોર્વેજિયન બોકમાલ",
+		Nya: "ન્યાન્જા",
+		Ori: "ઉડિયા",
+		Orm: "ઓરોમો",
+		Pan: "પંજાબી",
+		Pes: "ફારસી",
+		Pol: "પોલીશ",
+		Por: "પોર્ટુગીઝ",
+		Ron: "રોમાનિયન",
+		Run: "રૂન્દી",
+		Rus: "રશિયન",
+		Sin: "સિંહાલી",
+		Slv: "સ્લોવેનિયન",
+		Sna: "શોના",
+		Som: "સોમાલી",
+		Spa: "સ્પેનિશ",
+		Srp: "સર્બિયન",
+		Swe: "સ્વીડિશ",
+		Tam: "તમિલ",
+		Tel: "તેલુગુ",
+		Tgl: "ફિલિપિનો",
+		Tha: "થાઈ",
+		Tir: "ટાઇગ્રિનિયા",
+		Tuk: "તુર્કમેન",
+		Tur: "ટર્કિશ",
+		Uig: "ઉઇગુર",
+		Ukr: "યુક્રેનિયન",
+		Urd: "ઉર્દૂ",
+		Uzb: "ઉઝ્બેક",
+		Vie: "વિયેતનામીસ",
+		Ydd: "યિદ્દિશ",
+		Yor: "યોરૂબા",
+		Zul: "ઝુલુ",
+	},
+	Hau: {
+		Aka: "Akan",
+		Amh: "Amharik",
+		Arb: "Larabci",
+		Bel: "Belarusanci",
+		Ben: "Bengali",
+		Bul: "Bulgaranci",
+		Ces: "Harshen Cak",
+		Cmn: "Harshen Sin",
+		Deu: "Jamusanci",
+		Ell: "Girkanci",
+		Eng: "Turanci",
+		Fra: "Faransanci",
+		Hau: "Hausa",
+		Hin: "Harshen Hindi",
+		Hun: "Harshen Hungari",
+		Ibo: "Inyamuranci",
+		Ind: "Harshen Indunusiya",
+		Ita: "Italiyanci",
+		Jav: "Jabananci",
+		Jpn: "Japananci",
+		Khm: "Harshen Kimar",
+		Kin: "Kiniyaruwanda",
+		Kor: "Harshen Koreya",
+		Mya: "Burmanci",
+		Nep: "Nepali",
+		Nld: "Holanci",
+		Pan: "Punjabi",
+		Pes: "Parisanci",
+		Pol: "Harshen Polan",
+		Por: "Harshen Portugal",
+		Ron: "Romaniyanci",
+		Rus: "Rashanci",
+		Som: "Somali",
+		Spa: "Ispaniyanci",
+		Swe: "Harshen Suwedan",
+		Tam: "Tamil",
+		Tha: "Thai",
+		Tur: "Harshen Turkiyya",
+		Ukr: "Harshen Yukuren",
+		Urd: "Harshen Urdu",
+		Vie: "Harshen Biyetinam",
+		Yor: "Yarbanci",
+		Zul: "Harshen Zulu",
+	},
+	Heb: {
+		Afr: "אפריקאנס",
+		Aka: "אקאן",
+		Amh: "אמהרית",
+		Arb: "ערבית",
+		Azj: "אזרית",
+		Bel: "בלארוסית",
+		Ben: "בנגלית",
+		Bho: "בוג׳פורי",
+		Bul: "בולגרית",
+		Ceb: "סבואנו",
+		Ces: "צ׳כית",
+		Cmn: "סינית",
+		Dan: "דנית",
+		Deu: "גרמנית",
+		Ell: "יוונית",
+		Eng: "אנגלית",
+		Epo: "אספרנטו",
+		Est: "אסטונית",
+		Fin: "פינית",
+		Fra: "צרפתית",
+		Guj: "גוג׳ארטי",
+		Hat: "קריאולית (האיטי)",
+		Hau: "האוסה",
+		Heb: "עברית",
+		Hin: "הינדי",
+		Hrv: "קרואטית",
+		Hun: "הונגרית",
+		Ibo: "איגבו",
+		Ilo: "אילוקו",
+		Ind: "אינדונזית",
+		Ita: "איטלקית",
+		Jav: "יאוואית",
+		Jpn: "יפנית",
+		Kan: "קנאדה",
+		Kat: "גאורגית",
+		Khm: "חמרית",
+		Kin: "קנירואנדית",
+		Kor: "קוריאנית",
+		Kur: "כורדית",
+		Lav: "לטבית",
+		Lit: "ליטאית",
+		Mai: "מאיטילית",
+		Mal: "מליאלאם",
+		Mar: "מראטהי",
+		Mkd: "מקדונית",
+		Mlg: "מלגשית",
+		Mya: "בורמזית",
+		Nep: "נפאלית",
+		Nld: "הולנדית",
+		Nno: "נורווגית חדשה",
+		Nob: "נורווגית ספרותית",
+		Nya: "ניאנג׳ה",
+		Ori: "אורייה",
+		Orm: "אורומו",
+		Pan: "פנג׳אבי",
+		Pes: "פרסית",
+		Pol: "פולנית",
+		Por: "פורטוגזית",
+		Ron: "רומנית",
+		Run: "קירונדי",
+		Rus: "רוסית",
+		Sin: "סינהלה",
+		Slv: "סלובנית",
+		Sna: "שונה",
+		Som: "סומלית",
+		Spa: "ספרדית",
+		Srp: "סרבית",
+		Swe: "שוודית",
+		Tam: "טמילית",
+		Tel: "טלוגו",
+		Tgl: "פיליפינית",
+		Tha: "תאית",
+		Tir: "תיגרינית",
+		Tuk: "טורקמנית",
+		Tur: "טורקית",
+		Uig: "אויגור",
+		Ukr: "אוקראינית",
+		Urd: "אורדו",
+		Uzb: "אוזבקית",
+		Vie: "ויאטנמית",
+		Ydd: "יידיש",
+		Yor: "יורובה",
+		Zul: "זולו",
+	},
+	Hin: {
+		Afr: "अफ़्रीकी",
+		Aka: "अकन",
+		Amh: "अम्हेरी",
+		Arb: "अरबी",
+		Azj: "अज़रबैजानी",
+		Bel: "बेलारूसी",
+		Ben: "बंगाली",
+		Bho: "भोजपुरी",
+		Bul: "बुल्गारियाई",
+		Ceb: "सिबुआनो",
+		Ces: "चेक",
+		Cmn: "चीनी",
+		Dan: "डेनिश",
+		Deu: "जर्मन",
+		Ell: "यूनानी",
+		Eng: "अंग्रेज़ी",
+		Epo: "एस्पेरेंतो",
+		Est: "एस्टोनियाई",
+		Fin: "फ़िनिश",
+		Fra: "फ़्रेंच",
+		Guj: "गुजराती",
+		Hat: "हैतियाई",
+		Hau: "हौसा",
+		Heb: "हिब्रू",
+		Hin: "हिन्दी",
+		Hrv: "क्रोएशियाई",
+		Hun: "हंगेरियाई",
+		Ibo: "ईग्बो",
+		Ilo: "इलोको",
+		Ind: "इंडोनेशियाई",
+		Ita: "इतालवी",
+		Jav: "जावानीज़",
+		Jpn: "जापानी",
+		Kan: "कन्नड़",
+		Kat: "जॉर्जियाई",
+		Khm: "खमेर",
+		Kin: "किन्यारवांडा",
+		Kor: "कोरियाई",
+		Kur: "कुर्दिश",
+		Lav: "लातवियाई",
+		Lit: "लिथुआनियाई",
+		Mai: "मैथिली",
+		Mal: "मलयालम",
+		Mar: "मराठी",
+		Mkd: "मकदूनियाई",
+		Mlg: "मालागासी",
+		Mya: "बर्मीज़",
+		Nep: "नेपाली",
+		Nld: "डच",
+		Nno: "नॉर्वेजियाई नॉयनॉर्स्क",
+		Nob: "नॉर्वेजियाई बोकमाल",
+		Nya: "न्यानजा",
+		Ori: "उड़िया",
+		Orm: "ओरोमो",
+		Pan: "पंजाबी",
+		Pes: "फ़ारसी",
+		Pol: "पोलिश",
+		Por: "पुर्तगाली",
+		Ron: "रोमानियाई",
+		Run: "रुन्दी",
+		Rus: "रूसी",
+		Sin: "सिंहली",
+		Slv: "स्लोवेनियाई",
+		Sna: "शोणा",
+		Som: "सोमाली",
+		Spa: "स्पेनी",
+		Srp: "सर्बियाई",
+		Swe: "स्वीडिश",
+		Tam: "तमिल",
+		Tel: "तेलुगू",
+		Tgl: "फ़िलिपीनो",
+		Tha: "थाई",
+		Tir: "तिग्रीन्या",
+		Tuk: "तुर्कमेन",
+		Tur: "तुर्की",
+		Uig: "विघुर",
+		Ukr: "यूक्रेनियाई",
+		Urd: "उर्दू",
+		Uzb: "उज़्बेक",
+		Vie: "वियतनामी",
+		Ydd: "यहूदी",
+		Yor: "योरूबा",
+		Zul: "ज़ुलू",
+	},
+	Hrv: {
+		Afr: "afrikaans",
+		Aka: "akanski",
+		Amh: "amharski",
+		Arb: "arapski",
+		Azj: "azerbajdžanski",
+		Bel: "bjeloruski",
+		Ben: "bangla",
+		Bho: "bhojpuri",
+		Bul: "bugarski",
+		Ceb: "cebuano",
+		Ces: "češki",
+		Cmn: "kineski",
+		Dan: "danski",
+		Deu: "njemački",
+		Ell: "grčki",
+		Eng: "engleski",
+		Epo: "esperanto",
+		Est: "estonski",
+		Fin: "finski",
+		Fra: "francuski",
+		Guj: "gudžaratski",
+		Hat: "haićanski kreolski",
+		Hau: "hausa",
+		Heb: "hebrejski",
+		Hin: "hindski",
+		Hrv: "hrvatski",
+		Hun: "mađarski",
+		Ibo: "igbo",
+		Ilo: "iloko",
+		Ind: "indonezijski",
+		Ita: "talijanski",
+		Jav: "javanski",
+		Jpn: "japanski",
+		Kan: "karnatački",
+		Kat: "gruzijski",
+		Khm: "kmerski",
+		Kin: "kinyarwanda",
+		Kor: "korejski",
+		Kur: "kurdski",
+		Lav: "latvijski",
+		Lit: "litavski",
+		Mai: "maithili",
+		Mal: "malajalamski",
+		Mar: "marathski",
+		Mkd: "makedonski",
+		Mlg: "malgaški",
+		Mya: "burmanski",
+		Nep: "nepalski",
+		Nld: "nizozemski",
+		Nno: "norveški nynorsk",
+		Nob: "norveški bokmål",
+		Nya: "njandža",
+		Ori: "orijski",
+		Orm: "oromski",
+		Pan: "pandžapski",
+		Pes: "perzijski",
+		Pol: "poljski",
+		Por: "portugalski",
+		Ron: "rumunjski",
+		Run: "rundi",
+		Rus: "ruski",
+		Sin: "sinhaleški",
+		Slv: "slovenski",
+		Sna: "shona",
+		Som: "somalski",
+		Spa: "španjolski",
+		Srp: "srpski",
+		Swe: "švedski",
+		Tam: "tamilski",
+		Tel: "teluški",
+		Tgl: "filipinski",
+		Tha: "tajlandski",
+		Tir: "tigrinja",
+		Tuk: "turkmenski",
+		Tur: "turski",
+		Uig: "ujgurski",
+		Ukr: "ukrajinski",
+		Urd: "urdski",
+		Uzb: "uzbečki",
+		Vie: "vijetnamski",
+		Ydd: "jidiš",
+		Yor: "jorupski",
+		Zul: "zulu",
+	},
+	Hun: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amhara",
+		Arb: "arab",
+		Azj: "azerbajdzsáni",
+		Bel: "belarusz",
+		Ben: "bangla",
+		Bho: "bodzspuri",
+		Bul: "bolgár",
+		Ceb: "szebuano",
+		Ces: "cseh",
+		Cmn: "kínai",
+		Dan: "dán",
+		Deu: "német",
+		Ell: "görög",
+		Eng: "angol",
+		Epo: "eszperantó",
+		Est: "észt",
+		Fin: "finn",
+		Fra: "francia",
+		Guj: "gudzsaráti",
+		Hat: "haiti kreol",
+		Hau: "hausza",
+		Heb: "héber",
+		Hin: "hindi",
+		Hrv: "horvát",
+		Hun: "magyar",
+		Ibo: "igbó",
+		Ilo: "ilokó",
+		Ind: "indonéz",
+		Ita: "olasz",
+		Jav: "jávai",
+		Jpn: "japán",
+		Kan: "kannada",
+		Kat: "grúz",
+		Khm: "khmer",
+		Kin: "kinyarvanda",
+		Kor: "koreai",
+		Kur: "kurd",
+		Lav: "lett",
+		Lit: "litván",
+		Mai: "maithili",
+		Mal: "malajálam",
+		Mar: "maráthi",
+		Mkd: "macedón",
+		Mlg: "malgas",
+		Mya: "burmai",
+		Nep: "nepáli",
+		Nld: "holland",
+		Nno: "norvég (nynorsk)",
+		Nob: "norvég (bokmål)",
+		Nya: "nyandzsa",
+		Ori: "odia",
+		Orm: "oromo",
+		Pan: "pandzsábi",
+		Pes: "perzsa",
+		Pol: "lengyel",
+		Por: "portugál",
+		Ron: "román",
+		Run: "kirundi",
+		Rus: "orosz",
+		Sin: "szingaléz",
+		Slv: "szlovén",
+		Sna: "sona",
+		Som: "szomáli",
+		Spa: "spanyol",
+		Srp: "szerb",
+		Swe: "svéd",
+		Tam: "tamil",
+		Tel: "telugu",
+		Tgl: "filippínó",
+		Tha: "thai",
+		Tir: "tigrinya",
+		Tuk: "türkmén",
+		Tur: "török",
+		Uig: "ujgur",
+		Ukr: "ukrán",
+		Urd: "urdu",
+		Uzb: "üzbég",
+		Vie: "vietnami",
+		Ydd: "jiddis",
+		Yor: "joruba",
+		Zul: "zulu",
+	},
+	Ibo: {
+		Aka: "Akan",
+		Amh: "Amariikị",
+		Arb: "Arabiikị",
+		Bel: "Belaruusu",
+		Ben: "Bengali",
+		Bul: "Bọlụgarịa",
+		Ces: "Cheekị",
+		Cmn: "Mandarịịnị",
+		Deu: "Jamaan",
+		Ell: "Giriikị",
+		Eng: "Oyibo",
+		Fra: "Fụrench",
+		Hau: "Awụsa",
+		Hin: "Hindi",
+		Hun: "Magịya",
+		Ibo: "Igbo",
+		Ind: "Indonisia",
+		Ita: "Italo",
+		Jav: "Java",
+		Jpn: "Japanese",
+		Khm: "Keme, Etiti",
+		Kin: "Rụwanda",
+		Kor: "Koria",
+		Mya: "Mịanma",
+		Nep: "Nepali",
+		Nld: "Dọọch",
+		Pan: "Punjabi",
+		Pes: "Peshan",
+		Pol: "Poliishi",
+		Por: "Potoki",
+		Ron: "Rumenia",
+		Rus: "Rọshan",
+		Som: "Somali",
+		Spa: "Panya",
+		Swe: "Sụwidiishi",
+		Tam: "Tamụlụ",
+		Tha: "Taị",
+		Tur: "Tọkiishi",
+		Ukr: "Ukureenị",
+		Urd: "Urudu",
+		Vie: "Viyetịnaamụ",
+		Yor: "Yoruba",
+		Zul: "Zulu",
+	},
+	Ind: {
+		Afr: "Afrikaans",
+		Aka: "Akan",
+		Amh: "Amharik",
+		Arb: "Arab",
+		Azj: "Azerbaijani",
+		Bel: "Belarusia",
+		Ben: "Bengali",
+		Bho: "Bhojpuri",
+		Bul: "Bulgaria",
+		Ceb: "Cebuano",
+		Ces: "Cheska",
+		Cmn: "Tionghoa",
+		Dan: "Dansk",
+		Deu: "Jerman",
+		Ell: "Yunani",
+		Eng: "Inggris",
+		Epo: "Esperanto",
+		Est: "Esti",
+		Fin: "Suomi",
+		Fra: "Prancis",
+		Guj: "Gujarat",
+		Hat: "Kreol Haiti",
+		Hau: "Hausa",
+		Heb: "Ibrani",
+		Hin: "Hindi",
+		Hrv: "Kroasia",
+		Hun: "Hungaria",
+		Ibo: "Igbo",
+		Ilo: "Iloko",
+		Ind: "Indonesia",
+		Ita: "Italia",
+		Jav: "Jawa",
+		Jpn: "Jepang",
+		Kan: "Kannada",
+		Kat: "Georgia",
+		Khm: "Khmer",
+		Kin: "Kinyarwanda",
+		Kor: "Korea",
+		Kur: "Kurdi",
+		Lav: "Latvi",
+		Lit: "Lituavi",
+		Mai: "Maithili",
+		Mal: "Malayalam",
+		Mar: "Marathi",
+		Mkd: "Makedonia",
+		Mlg: "Malagasi",
+		Mya: "Burma",
+		Nep: "Nepali",
+		Nld: "Belanda",
+		Nno: "Nynorsk Norwegia",
+		Nob: "Bokmål Norwegia",
+		Nya: "Nyanja",
+		Ori: "Oriya",
+		Orm: "Oromo",
+		Pan: "Punjabi",
+		Pes: "Persia",
+		Pol: "Polski",
+		Por: "Portugis",
+		Ron: "Rumania",
+		Run: "Rundi",
+		Rus: "Rusia",
+		Sin: "Sinhala",
+		Slv: "Sloven",
+		Sna: "Shona",
+		Som: "Somalia",
+		Spa: "Spanyol",
+		Srp: "Serbia",
+		Swe: "Swedia",
+		Tam: "Tamil",
+		Tel: "Telugu",
+		Tgl: "Filipino",
+		Tha: "Thai",
+		Tir: "Tigrinya",
+		Tuk: "Turkmen",
+		Tur: "Turki",
+		Uig: "Uyghur",
+		Ukr: "Ukraina",
+		Urd: "Urdu",
+		Uzb: "Uzbek",
+		Vie: "Vietnam",
+		Ydd: "Yiddish",
+		Yor: "Yoruba",
+		Zul: "Zulu",
+	},
+	Ita: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amarico",
+		Arb: "arabo",
+		Azj: "azerbaigiano",
+		Bel: "bielorusso",
+		Ben: "bengalese",
+		Bho: "bhojpuri",
+		Bul: "bulgaro",
+		Ceb: "cebuano",
+		Ces: "ceco",
+		Cmn: "cinese",
+		Dan: "danese",
+		Deu: "tedesco",
+		Ell: "greco",
+		Eng: "inglese",
+		Epo: "esperanto",
+		Est: "estone",
+		Fin: "finlandese",
+		Fra: "francese",
+		Guj: "gujarati",
+		Hat: "haitiano",
+		Hau: "hausa",
+		Heb: "ebraico",
+		Hin: "hindi",
+		Hrv: "croato",
+		Hun: "ungherese",
+		Ibo: "igbo",
+		Ilo: "ilocano",
+		Ind: "indonesiano",
+		Ita: "italiano",
+		Jav: "giavanese",
+		Jpn: "giapponese",
+		Kan: "kannada",
+		Kat: "georgiano",
+		Khm: "khmer",
+		Kin: "kinyarwanda",
+		Kor: "coreano",
+		Kur: "curdo",
+		Lav: "lettone",
+		Lit: "lituano",
+		Mai: "maithili",
+		Mal: "malayalam",
+		Mar: "marathi",
+		Mkd: "macedone",
+		Mlg: "malgascio",
+		Mya: "birmano",
+		Nep: "nepalese",
+		Nld: "olandese",
+		Nno: "norvegese nynorsk",
+		Nob: "norvegese bokmål",
+		Nya: "nyanja",
+		Ori: "oriya",
+		Orm: "oromo",
+		Pan: "punjabi",
+		Pes: "persiano",
+		Pol: "polacco",
+		Por: "portoghese",
+		Ron: "rumeno",
+		Run: "rundi",
+		Rus: "russo",
+		Sin: "singalese",
+		Slv: "sloveno",
+		Sna: "shona",
+		Som: "somalo",
+		Spa: "spagnolo",
+		Srp: "serbo",
+		Swe: "svedese",
+		Tam: "tamil",
+		Tel: "telugu",
+		Tgl: "filippino",
+		Tha: "thai",
+		Tir: "tigrino",
+		Tuk: "turcomanno",
+		Tur: "turco",
+		Uig: "uiguro",
+		Ukr: "ucraino",
+		Urd: "urdu",
+		Uzb: "uzbeco",
+		Vie: "vietnamita",
+		Ydd: "yiddish",
+		Yor: "yoruba",
+		Zul: "zulu",
+	},
+	Jpn: {
+		Afr: "アフリカーンス語",
+		Aka: "アカン語",
+		Amh: "アムハラ語",
+		Arb: "アラビア語",
+		Azj: "アゼルバイジャン語",
+		Bel: "ベラルーシ語",
+		Ben: "ベンガル語",
+		Bho: "ボージュプリー語",
+		Bul: "ブルガリア語",
+		Ceb: "セブアノ語",
+		Ces: "チェコ語",
+		Cmn: "中国語",
+		Dan: "デンマーク語",
+		Deu: "ドイツ語",
+		Ell: "ギリシャ語",
+		Eng: "英語",
+		Epo: "エスペラント語",
+		Est: "エストニア語",
+		Fin: "フィンランド語",
+		Fra: "フランス語",
+		Guj: "グジャラート語",
+		Hat: "ハイチ・クレオール語",
+		Hau: "ハウサ語",
+		Heb: "ヘブライ語",
+		Hin: "ヒンディー語",
+		Hrv: "クロアチア語",
+		Hun: "ハンガリー語",
+		Ibo: "イボ語",
+		Ilo: "イロカノ語",
+		Ind: "インドネシア語",
+		Ita: "イタリア語",
+		Jav: "ジャワ語",
+		Jpn: "日本語",
+		Kan: "カンナダ語",
+		Kat: "ジョージア語",
+		Khm: "クメール語",
+		Kin: "キニアルワンダ語",
+		Kor: "韓国語",
+		Kur: "クルド語",
+		Lav: "ラトビア語",
+		Lit: "リトアニア語",
+		Mai: "マイティリー語",
+		Mal: "マラヤーラム語",
+		Mar: "マラーティー語",
+		Mkd: "マケドニア語",
+		Mlg: "マダガスカル語",
+		Mya: "ミャンマー語",
+		Nep: "ネパール語",
+		Nld: "オランダ語",
+		Nno: "ノルウェー語(ニーノシュク)",
+		Nob: "ノルウェー語(ブークモール)",
+		Nya: "ニャンジャ語",
+		Ori: "オリヤー語",
+		Orm: "オロモ語",
+		Pan: "パンジャブ語",
+		Pes: "ペルシア語",
+		Pol: "ポーランド語",
+		Por: "ポルトガル語",
+		Ron: "ルーマニア語",
+		Run: "ルンディ語",
+		Rus: "ロシア語",
+		Sin: "シンハラ語",
+		Slv: "スロベニア語",
+		Sna: "ショナ語",
+		Som: "ソマリ語",
+		Spa: "スペイン語",
+		Srp: "セルビア語",
+		Swe: "スウェーデン語",
+		Tam: "タミル語",
+		Tel: "テルグ語",
+		Tgl: "フィリピノ語",
+		Tha: "タイ語",
+		Tir: "ティグリニア語",
+		Tuk: "トルクメン語",
+		Tur: "トルコ語",
+		Uig: "ウイグル語",
+		Ukr: "ウクライナ語",
+		Urd: "ウルドゥー語",
+		Uzb: "ウズベク語",
+		Vie: "ベトナム語",
+		Ydd: "イディッシュ語",
+		Yor: "ヨルバ語",
+		Zul: "ズールー語",
+	},
+	Kan: {
+		Afr: "ಆಫ್ರಿಕಾನ್ಸ್",
+		Aka: "ಅಕಾನ್",
+		Amh: "ಅಂಹರಿಕ್",
+		Arb: "ಅರೇಬಿಕ್",
+		Azj: "ಅಜೆರ್ಬೈಜಾನಿ",
+		Bel: "ಬೆಲರೂಸಿಯನ್",
+		Ben: "ಬಾಂಗ್ಲಾ",
+		Bho: "ಭೋಜಪುರಿ",
+		Bul: "ಬಲ್ಗೇರಿಯನ್",
+		Ceb: "ಸೆಬುವಾನೊ",
+		Ces: "ಜೆಕ್",
+		Cmn: "ಚೈನೀಸ್",
+		Dan: "ಡ್ಯಾನಿಶ್",
+		Deu: "ಜರ್ಮನ್",
+		Ell: "ಗ್ರೀಕ್",
+		Eng: "ಇಂಗ್ಲಿಷ್",
+		Epo: "ಎಸ್ಪೆರಾಂಟೊ",
+		Est: "ಎಸ್ಟೊನಿಯನ್",
+		Fin: "ಫಿನ್ನಿಶ್",
+		Fra: "ಫ್ರೆಂಚ್",
+		Guj: "ಗುಜರಾತಿ",
+		Hat: "ಹೈಟಿಯನ್ ಕ್ರಿಯೋಲಿ",
+		Hau: "ಹೌಸಾ",
+		Heb: "ಹೀಬ್ರೂ",
+		Hin: "ಹಿಂದಿ",
+		Hrv: "ಕ್ರೊಯೇಶಿಯನ್",
+		Hun: "ಹಂಗೇರಿಯನ್",
+		Ibo: "ಇಗ್ಬೊ",
+		Ilo: "ಇಲ್ಲಿಕೋ",
+		Ind: "ಇಂಡೋನೇಶಿಯನ್",
+		Ita: "ಇಟಾಲಿಯನ್",
+		Jav: "ಜಾವಾನೀಸ್",
+		Jpn: "ಜಾಪನೀಸ್",
+		Kan: "ಕನ್ನಡ",
+		Kat: "ಜಾರ್ಜಿಯನ್",
+		Khm: "ಖಮೇರ್",
+		Kin: "ಕಿನ್ಯಾರ್\u200cವಾಂಡಾ",
+		Kor: "ಕೊರಿಯನ್",
+		Kur: "ಕುರ್ದಿಷ್",
+		Lav: "ಲಾಟ್ವಿಯನ್",
+		Lit: "ಲಿಥುವೇನಿಯನ್",
+		Mai: "ಮೈಥಿಲಿ",
+		Mal: "ಮಲಯಾಳಂ",
+		Mar: "ಮರಾಠಿ",
+		Mkd: "ಮೆಸಿಡೋನಿಯನ್",
+		Mlg: "ಮಲಗಾಸಿ",
+		Mya: "ಬರ್ಮೀಸ್",
+		Nep: "ನೇಪಾಳಿ",
+		Nld: "ಡಚ್",
+		Nno: "ನಾರ್ವೇಜಿಯನ್ ನೈನಾರ್ಸ್ಕ್",
+		Nob: "ನಾರ್ವೆಜಿಯನ್ ಬೊಕ್ಮಲ್",
+		Nya: "ನ್ಯಾಂಜಾ",
+		Ori: "ಒಡಿಯ",
+		Orm: "ಒರೊಮೊ",
+		Pan: "ಪಂಜಾಬಿ",
+		Pes: "ಪರ್ಶಿಯನ್",
+		Pol: "ಪೊಲಿಶ್",
+		Por: "ಪೋರ್ಚುಗೀಸ್",
+		Ron: "ರೊಮೇನಿಯನ್",
+		Run: "ರುಂಡಿ",
+		Rus: "ರಷ್ಯನ್",
+		Sin: "ಸಿಂಹಳ",
+		Slv: "ಸ್ಲೋವೇನಿಯನ್",
+		Sna: "ಶೋನಾ",
+		Som: "ಸೊಮಾಲಿ",
+		Spa: "ಸ್ಪ್ಯಾನಿಷ್",
+		Srp: "ಸೆರ್ಬಿಯನ್",
+		Swe: "ಸ್ವೀಡಿಷ್",
+		Tam: "ತಮಿಳು",
+		Tel: "ತೆಲುಗು",
+		Tgl: "ಫಿಲಿಪಿನೊ",
+		Tha: "ಥಾಯ್",
+		Tir: "ಟಿಗ್ರಿನ್ಯಾ",
+		Tuk: "ಟರ್ಕ್\u200cಮೆನ್",
+		Tur: "ಟರ್ಕಿಶ್",
+		Uig: "ಉಯಿಘರ್",
+		Ukr: "ಉಕ್ರೇನಿಯನ್",
+		Urd: "ಉರ್ದು",
+		Uzb: "ಉಜ್ಬೇಕ್",
+		Vie: "ವಿಯೆಟ್ನಾಮೀಸ್",
+		Ydd: "ಯಿಡ್ಡಿಶ್",
+		Yor: "ಯೊರುಬಾ",
+		Zul: "ಜುಲು",
+	},
+	Kat: {
+		Afr: "აფრიკაანსი",
+		Aka: "აკანი",
+		Amh: "ამჰარული",
+		Arb: "არაბული",
+		Azj: "აზერბაიჯანული",
+		Bel: "ბელორუსული",
+		Ben: "ბენგალური",
+		Bho: "ბოჯპური",
+		Bul: "ბულგარული",
+		Ceb: "სებუანო",
+		Ces: "ჩეხური",
+		Cmn: "ჩინური",
+		Dan: "დანიური",
+		Deu: "გერმანული",
+		Ell: "ბერძნული",
+		Eng: "ინგლისური",
+		Epo: "ესპერანტო",
+		Est: "ესტონური",
+		Fin: "ფინური",
+		Fra: "ფრანგული",
+		Guj: "გუჯარათი",
+		Hat: "ჰაიტიური კრეოლი",
+		Hau: "ჰაუსა",
+		Heb: "ებრაული",
+		Hin: "ჰინდი",
+		Hrv: "ხორვატული",
+		Hun: "უნგრული",
+		Ibo: "იგბო",
+		Ilo: "ილოკო",
+		Ind: "ინდონეზიური",
+		Ita: "იტალიური",
+		Jav: "იავური",
+		Jpn: "იაპონური",
+		Kan: "კანადა",
+		Kat: "ქართული",
+		Khm: "ქმერული",
+		Kin: "კინიარუანდა",
+		Kor: "კორეული",
+		Kur: "ქურთული",
+		Lav: "ლატვიური",
+		Lit: "ლიტვური",
+		Mai: "მაითილი",
+		Mal: "მალაიალამური",
+		Mar: "მარათჰი",
+		Mkd: "მაკედონური",
+		Mlg: "მალაგასიური",
+		Mya: "ბირმული",
+		Nep: "ნეპალური",
+		Nld: "ნიდერლანდური",
+		Nno: "ნორვეგიული ნიუნორსკი",
+		Nob: "ნორვეგიული ბუკმოლი",
+		Nya: "ნიანჯა",
+		Ori: "ორია",
+		Orm: "ორომო",
+		Pan: "პენჯაბური",
+		Pes: "სპარსული",
+		Pol: "პოლონური",
+		Por: "პორტუგალიური",
+		Ron: "რუმინული",
+		Run: "რუნდი",
+		Rus: "რუსული",
+		Sin: "სინჰალური",
+		Slv: "სლოვენური",
+		Sna: "შონა",
+		Som: "სომალიური",
+		Spa: "ესპანური",
+		Srp: "სერბული",
+		Swe: "შვედური",
+		Tam: "ტამილური",
+		Tel: "ტელუგუ",
+		Tgl: "ფილიპინური",
+		Tha: "ტაი",
+		Tir: "ტიგრინია",
+		Tuk: "თურქმენული",
+		Tur: "თურქული",
+		Uig: "უიღურული",
+		Ukr: "უკრაინული",
+		Urd: "ურდუ",
+		Uzb: "უზბეკური",
+		Vie: "ვიეტნამური",
+		Ydd: "იდიში",
+		Yor: "იორუბა",
+		Zul: "ზულუ",
+	},
+	Khm: {
+		Afr: "អាហ្វ្រិកាន",
+		Aka: "អាកាន",
+		Amh: "អាំហារិក",
+		Arb: "អារ៉ាប់",
+		Azj: "អាស៊ែបៃហ្សង់",
+		Bel: "បេឡារុស",
+		Ben: "បង់ក្លាដែស",
+		Bho: "បូចពូរី",
+		Bul: "ប៊ុលហ្គារី",
+		Ceb: "ស៊ីប៊ូអាណូ",
+		Ces: "ឆែក",
+		Cmn: "ចិន",
+		Dan: "ដាណឺម៉ាក",
+		Deu: "អាល្លឺម៉ង់",
+		Ell: "ក្រិក",
+		Eng: "អង់គ្លេស",
+		Epo: "អេស្ពេរ៉ាន់តូ",
+		Est: "អេស្តូនី",
+		Fin: "ហ្វាំងឡង់",
+		Fra: "បារាំង",
+		Guj: "ហ្កុយ៉ារាទី",
+		Hat: "ហៃទី",
+		Hau: "ហូសា",
+		Heb: "ហេប្រឺ",
+		Hin: "ហិណ្ឌី",
+		Hrv: "ក្រូអាត",
+		Hun: "ហុងគ្រី",
+		Ibo: "អ៊ីកបូ",
+		Ilo: "អ៊ីឡូកូ",
+		Ind: "ឥណ្ឌូណេស៊ី",
+		Ita: "អ៊ីតាលី",
+		Jav: "ជ្វា",
+		Jpn: "ជប៉ុន",
+		Kan: "ខាណាដា",
+		Kat: "ហ្សក\u200bហ្ស៊ី",
+		Khm: "ខ្មែរ",
+		Kin: "គិនយ៉ាវ៉ាន់ដា",
+		Kor: "កូរ៉េ",
+		Kur: "ឃឺដ",
+		Lav: "ឡាតវី",
+		Lit: "លីទុយអានី",
+		Mai: "ម៉ៃធីលី",
+		Mal: "ម៉ាឡាយ៉ាឡាម",
+		Mar: "ម៉ារ៉ាធី",
+		Mkd: "ម៉ាសេដូនី",
+		Mlg: "ម៉ាឡាហ្គាស៊ី",
+		Mya: "ភូមា",
+		Nep: "នេប៉ាល់",
+		Nld: "ហូឡង់",
+		Nno: "ន័រវែស នីនូស",
+		Nob: "ន័រវែស បុកម៉ាល់",
+		Nya: "ណានចា",
+		Ori: "អូឌៀ",
+		Orm: "អូរ៉ូម៉ូ",
+		Pan: "បឹនជាពិ",
+		Pes: "ភឺសៀន",
+		Pol: "ប៉ូឡូញ",
+		Por: "ព័រទុយហ្គាល់",
+		Ron: "រូម៉ានី",
+		Run: "រុណ្ឌី",
+		Rus: "រុស្ស៊ី",
+		Sin: "ស្រីលង្កា",
+		Slv: "ស្លូវ៉ានី",
+		Sna: "សូណា",
+		Som: "សូម៉ាលី",
+		Spa: "អេស្ប៉ាញ",
+		Srp: "ស៊ែប",
+		Swe: "ស៊ុយអែត",
+		Tam: "តាមីល",
+		Tel: "តេលុគុ",
+		Tgl: "ហ្វីលីពីន",
+		Tha: "ថៃ",
+		Tir: "ទីហ្គ្រីញ៉ា",
+		Tuk: "តួកម៉េន",
+		Tur: "ទួរគី",
+		Uig: "អ៊ុយហ្គឺរ",
+		Ukr: "អ៊ុយក្រែន",
+		Urd: "អ៊ូរឌូ",
+		Uzb: "អ៊ូសបេគ",
+		Vie: "វៀតណាម",
+		Ydd: "យ៉ីឌីស",
+		Yor: "យរូបា",
+		Zul: "ហ្សូលូ",
+	},
+	Kin: {
+		Afr: "Ikinyafurikaneri",
+		Aka: "Inyetuwi",
+		Amh: "Inyamuhariki",
+		Arb: "Icyarabu",
+		Azj: "Inyazeribayijani",
+		Bel: "Ikibelarusiya",
+		Ben: "Ikibengali",
+		Bul: "Urunyabuligariya",
+		Ces: "Igiceke",
+		Dan: "Ikidaninwa",
+		Deu: "Ikidage",
+		Ell: "Ikigereki",
+		Eng: "Icyongereza",
+		Epo: "Icyesiperanto",
+		Est: "Icyesitoniya",
+		Fin: "Igifinilande",
+		Fra: "Igifaransa",
+		Guj: "Inyegujarati",
+		Heb: "Igiheburayo",
+		Hin: "Igihindi",
+		Hrv: "Igikorowasiya",
+		Hun: "Igihongiriya",
+		Ind: "Ikinyendoziya",
+		Ita: "Igitaliyani",
+		Jav: "Inyejava",
+		Jpn: "Ikiyapani",
+		Kan: "Igikanada",
+		Kat: "Inyejeworujiya",
+		Khm: "Igikambodiya",
+		Kin: "Kinyarwanda",
+		Kor: "Igikoreya",
+		Kur: "Inyekuridishi",
+		Lav: "Ikinyaletoviyani",
+		Lit: "Ikilituwaniya",
+		Mal: "Ikimalayalami",
+		Mar: "Ikimarati",
+		Mkd: "Ikimasedoniya",
+		Nep: "Ikinepali",
+		Nld: "Ikinerilande",
+		Nno: "Inyenoruveji (Nyonorusiki)",
+		Nob: "Ikinoruveji",
+		Ori: "Inyoriya",
+		Pan: "Igipunjabi",
+		Pes: "Inyeperisi",
+		Pol: "Igipolone",
+		Por: "Igiporutugali",
+		Ron: "Ikinyarumaniya",
+		Rus: "Ikirusiya",
+		Sin: "Inyesimpaleze",
+		Slv: "Ikinyasiloveniya",
+		Som: "Igisomali",
+		Spa: "Icyesipanyolo",
+		Srp: "Igiseribe",
+		Swe: "Igisuweduwa",
+		Tam: "Igitamili",
+		Tel: "Igitelugu",
+		Tgl: "Ikinyafilipine",
+		Tha: "Igitayi",
+		Tir: "Inyatigirinya",
+		Tuk: "Inyeturukimeni",
+		Tur: "Igiturukiya",
+		Uig: "Ikiwiguri",
+		Ukr: "Ikinyayukereni",
+		Urd: "Inyeyurudu",
+		Uzb: "Inyeyuzubeki",
+		Vie: "Ikinyaviyetinamu",
+		Ydd: "Inyeyidishi",
+		Zul: "Inyezulu",
+	},
+	Kor: {
+		Afr: "아프리칸스어",
+		Aka: "아칸어",
+		Amh: "암하라어",
+		Arb: "아랍어",
+		Azj: "아제르바이잔어",
+		Bel: "벨라루스어",
+		Ben: "벵골어",
+		Bho: "호즈푸리어",
+		Bul: "불가리아어",
+		Ceb: "세부아노어",
+		Ces: "체코어",
+		Cmn: "중국어",
+		Dan: "덴마크어",
+		Deu: "독일어",
+		Ell: "그리스어",
+		Eng: "영어",
+		Epo: "에스페란토어",
+		Est: "에스토니아어",
+		Fin: "핀란드어",
+		Fra: "프랑스어",
+		Guj: "구자라트어",
+		Hat: "아이티어",
+		Hau: "하우사어",
+		Heb: "히브리어",
+		Hin: "힌디어",
+		Hrv: "크로아티아어",
+		Hun: "헝가리어",
+		Ibo: "이그보어",
+		Ilo: "이로코어",
+		Ind: "인도네시아어",
+		Ita: "이탈리아어",
+		Jav: "자바어",
+		Jpn: "일본어",
+		Kan: "칸나다어",
+		Kat: "조지아어",
+		Khm: "크메르어",
+		Kin: "르완다어",
+		Kor: "한국어",
+		Kur: "쿠르드어",
+		Lav: "라트비아어",
+		Lit: "리투아니아어",
+		Mai: "마이틸리어",
+		Mal: "말라얄람어",
+		Mar: "마라티어",
+		Mkd: "마케도니아어",
+		Mlg: "말라가시어",
+		Mya: "버마어",
+		Nep: "네팔어",
+		Nld: "네덜란드어",
+		Nno: "노르웨이어(니노르스크)",
+		Nob: "노르웨이어(보크말)",
+		Nya: "냔자어",
+		Ori: "오리야어",
+		Orm: "오로모어",
+		Pan: "펀잡어",
+		Pes: "페르시아어",
+		Pol: "폴란드어",
+		Por: "포르투갈어",
+		Ron: "루마니아어",
+		Run: "룬디어",
+		Rus: "러시아어",
+		Sin: "스리랑카어",
+		Slv: "슬로베니아어",
+		Sna: "쇼나어",
+		Som: "소말리아어",
+		Spa: "스페인어",
+		Srp: "세르비아어",
+		Swe: "스웨덴어",
+		Tam: "타밀어",
+		Tel: "텔루구어",
+		Tgl: "필리핀어",
+		Tha: "태국어",
+		Tir: "티그리냐어",
+		Tuk: "투르크멘어",
+		Tur: "터키어",
+		Uig: "위구르어",
+		Ukr: "우크라이나어",
+		Urd: "우르두어",
+		Uzb: "우즈베크어",
+		Vie: "베트남어",
+		Ydd: "이디시어",
+		Yor: "요루바어",
+		Zul: "줄루어",
+	},
+	Lav: {
+		Afr: "afrikandu",
+		Aka: "akanu",
+		Amh: "amharu",
+		Arb: "arābu",
+		Azj: "azerbaidžāņu",
+		Bel: "baltkrievu",
+		Ben: "bengāļu",
+		Bho: "bhodžpūru",
+		Bul: "bulgāru",
+		Ceb: "sebuāņu",
+		Ces: "čehu",
+		Cmn: "ķīniešu",
+		Dan: "dāņu",
+		Deu: "vācu",
+		Ell: "grieķu",
+		Eng: "angļu",
+		Epo: "esperanto",
+		Est: "igauņu",
+		Fin: "somu",
+		Fra: "franču",
+		Guj: "gudžaratu",
+		Hat: "haitiešu",
+		Hau: "hausu",
+		Heb: "ivrits",
+		Hin: "hindi",
+		Hrv: "horvātu",
+		Hun: "ungāru",
+		Ibo: "igbo",
+		Ilo: "iloku",
+		Ind: "indonēziešu",
+		Ita: "itāļu",
+		Jav: "javiešu",
+		Jpn: "japāņu",
+		Kan: "kannadu",
+		Kat: "gruzīnu",
+		Khm: "khmeru",
+		Kin: "kiņaruanda",
+		Kor: "korejiešu",
+		Kur: "kurdu",
+		Lav: "latviešu",
+		Lit: "lietuviešu",
+		Mai: "maithili",
+		Mal: "malajalu",
+		Mar: "marathu",
+		Mkd: "maķedoniešu",
+		Mlg: "malagasu",
+		Mya: "birmiešu",
+		Nep: "nepāliešu",
+		Nld: "holandiešu",
+		Nno: "jaunnorvēģu",
+		Nob: "norvēģu bukmols",
+		Nya: "čičeva",
+		Ori: "oriju",
+		Orm: "oromu",
+		Pan: "pandžabu",
+		Pes: "persiešu",
+		Pol: "poļu",
+		Por: "portugāļu",
+		Ron: "rumāņu",
+		Run: "rundu",
+		Rus: "krievu",
+		Sin: "singāļu",
+		Slv: "slovēņu",
+		Sna: "šonu",
+		Som: "somāļu",
+		Spa: "spāņu",
+		Srp: "serbu",
+		Swe: "zviedru",
+		Tam: "tamilu",
+		Tel: "telugu",
+		Tgl: "filipīniešu",
+		Tha: "taju",
+		Tir: "tigrinja",
+		Tuk: "turkmēņu",
+		Tur: "turku",
+		Uig: "uiguru",
+		Ukr: "ukraiņu",
+		Urd: "urdu",
+		Uzb: "uzbeku",
+		Vie: "vjetnamiešu",
+		Ydd: "jidišs",
+		Yor: "jorubu",
+		Zul: "zulu",
+	},
+	Lit: {
+		Afr: "afrikanų",
+		Aka: "akanų",
+		Amh: "amharų",
+		Arb: "arabų",
+		Azj: "azerbaidžaniečių",
+		Bel: "baltarusių",
+		Ben: "bengalų",
+		Bho: "baučpuri",
+		Bul: "bulgarų",
+		Ceb: "sebuanų",
+		Ces: "čekų",
+		Cmn: "kinų",
+		Dan: "danų",
+		Deu: "vokiečių",
+		Ell: "graikų",
+		Eng: "anglų",
+		Epo: "esperanto",
+		Est: "estų",
+		Fin: "suomių",
+		Fra: "prancūzų",
+		Guj: "gudžaratų",
+		Hat: "Haičio",
+		Hau: "hausų",
+		Heb: "hebrajų",
+		Hin: "hindi",
+		Hrv: "kroatų",
+		Hun: "vengrų",
+		Ibo: "igbų",
+		Ilo: "ilokų",
+		Ind: "indoneziečių",
+		Ita: "italų",
+		Jav: "javiečių",
+		Jpn: "japonų",
+		Kan: "kanadų",
+		Kat: "gruzinų",
+		Khm: "khmerų",
+		Kin: "kinjaruandų",
+		Kor: "korėjiečių",
+		Kur: "kurdų",
+		Lav: "latvių",
+		Lit: "lietuvių",
+		Mai: "maithili",
+		Mal: "malajalių",
+		Mar: "maratų",
+		Mkd: "makedonų",
+		Mlg: "malagasų",
+		Mya: "birmiečių",
+		Nep: "nepaliečių",
+		Nld: "olandų",
+		Nno: "naujoji norvegų",
+		Nob: "norvegų bukmolas",
+		Nya: "nianjų",
+		Ori: "odijų",
+		Orm: "oromų",
+		Pan: "pendžabų",
+		Pes: "persų",
+		Pol: "lenkų",
+		Por: "portugalų",
+		Ron: "rumunų",
+		Run: "rundi",
+		Rus: "rusų",
+		Sin: "sinhalų",
+		Slv: "slovėnų",
+		Sna: "šonų",
+		Som: "somaliečių",
+		Spa: "ispanų",
+		Srp: "serbų",
+		Swe: "švedų",
+		Tam: "tamilų",
+		Tel: "telugų",
+		Tgl: "filipiniečių",
+		Tha: "tajų",
+		Tir: "tigrajų",
+		Tuk: "turkmėnų",
+		Tur: "turkų",
+		Uig: "uigūrų",
+		Ukr: "ukrainiečių",
+		Urd: "urdų",
+		Uzb: "uzbekų",
+		Vie: "vietnamiečių",
+		Ydd: "jidiš",
+		Yor: "jorubų",
+		Zul: "zulų",
+	},
+	Mal: {
+		Afr: "ആഫ്രിക്കാൻസ്",
+		Aka: "അകാൻ\u200c",
+		Amh: "അംഹാരിക്",
+		Arb: "അറബിക്",
+		Azj: "അസർബൈജാനി",
+		Bel: "ബെലാറുഷ്യൻ",
+		Ben: "ബംഗാളി",
+		Bho: "ഭോജ്\u200cപുരി",
+		Bul: "ബൾഗേറിയൻ",
+		Ceb: "സെബുവാനോ",
+		Ces: "ചെക്ക്",
+		Cmn: "ചൈനീസ്",
+		Dan: "ഡാനിഷ്",
+		Deu: "ജർമ്മൻ",
+		Ell: "ഗ്രീക്ക്",
+		Eng: "ഇംഗ്ലീഷ്",
+		Epo: "എസ്\u200cപരാന്റോ",
+		Est: "എസ്റ്റോണിയൻ",
+		Fin: "ഫിന്നിഷ്",
+		Fra: "ഫ്രഞ്ച്",
+		Guj: "ഗുജറാത്തി",
+		Hat: "ഹെയ്\u200cതിയൻ ക്രിയോൾ",
+		Hau: "ഹൗസ",
+		Heb: "ഹീബ്രു",
+		Hin: "ഹിന്ദി",
+		Hrv: "ക്രൊയേഷ്യൻ",
+		Hun: "ഹംഗേറിയൻ",
+		Ibo: "ഇഗ്ബോ",
+		Ilo: "ഇലോകോ",
+		Ind: "ഇന്തോനേഷ്യൻ",
+		Ita: "ഇറ്റാലിയൻ",
+		Jav: "ജാവാനീസ്",
+		Jpn: "ജാപ്പനീസ്",
+		Kan: "കന്നഡ",
+		Kat: "ജോർജിയൻ",
+		Khm: "ഖമെർ",
+		Kin: "കിന്യാർവാണ്ട",
+		Kor: "കൊറിയൻ",
+		Kur: "കുർദ്ദിഷ്",
+		Lav: "ലാറ്റ്വിയൻ",
+		Lit: "ലിത്വാനിയൻ",
+		Mai: "മൈഥിലി",
+		Mal: "മലയാളം",
+		Mar: "മറാത്തി",
+		Mkd: "മാസിഡോണിയൻ",
+		Mlg: "മലഗാസി",
+		Mya: "ബർമീസ്",
+		Nep: "നേപ്പാളി",
+		Nld: "ഡച്ച്",
+		Nno: "നോർവീജിയൻ നൈനോർക്\u200cസ്",
+		Nob: "നോർവീജിയൻ ബുക്\u200cമൽ",
+		Nya: "ന്യൻജ",
+		Ori: "ഒഡിയ",
+		Orm: "ഒറോമോ",
+		Pan: "പഞ്ചാബി",
+		Pes: "പേർഷ്യൻ",
+		Pol: "പോളിഷ്",
+		Por: "പോർച്ചുഗീസ്",
+		Ron: "റൊമാനിയൻ",
+		Run: "റുണ്ടി",
+		Rus: "റഷ്യൻ",
+		Sin: "സിംഹള",
+		Slv: "സ്ലോവേനിയൻ",
+		Sna: "ഷോണ",
+		Som: "സോമാലി",
+		Spa: "സ്\u200cപാനിഷ്",
+		Srp: "സെർബിയൻ",
+		Swe: "സ്വീഡിഷ്",
+		Tam: "തമിഴ്",
+		Tel: "തെലുങ്ക്",
+		Tgl: "ഫിലിപ്പിനോ",
+		Tha: "തായ്",
+		Tir: "ടൈഗ്രിന്യ",
+		Tuk: "തുർക്\u200cമെൻ",
+		Tur: "ടർക്കിഷ്",
+		Uig: "ഉയ്ഘുർ",
+		Ukr: "ഉക്രേനിയൻ",
+		Urd: "ഉറുദു",
+		Uzb: "ഉസ്\u200cബെക്ക്",
+		Vie: "വിയറ്റ്നാമീസ്",
+		Ydd: "യിദ്ദിഷ്",
+		Yor: "യൊറൂബാ",
+		Zul: "സുലു",
+	},
+	Mar: {
+		Afr: "अफ्रिकान्स",
+		Aka: "अकान",
+		Amh: "अम्हारिक",
+		Arb: "अरबी",
+		Azj: "अझरबैजानी",
+		Bel: "बेलारुशियन",
+		Ben: "बंगाली",
+		Bho: "भोजपुरी",
+		Bul: "बल्गेरियन",
+		Ceb: "सिबुआनो",
+		Ces: "झेक",
+		Cmn: "चीनी",
+		Dan: "डॅनिश",
+		Deu: "जर्मन",
+		Ell: "ग्रीक",
+		Eng: "इंग्रजी",
+		Epo: "एस्परान्टो",
+		Est: "इस्टोनियन",
+		Fin: "फिन्निश",
+		Fra: "फ्रेंच",
+		Guj: "गुजराती",
+		Hat: "हैतीयन",
+		Hau: "हौसा",
+		Heb: "हिब्रू",
+		Hin: "हिंदी",
+		Hrv: "क्रोएशियन",
+		Hun: "हंगेरियन",
+		Ibo: "ईग्बो",
+		Ilo: "इलोको",
+		Ind: "इंडोनेशियन",
+		Ita: "इटालियन",
+		Jav: "जावानीज",
+		Jpn: "जपानी",
+		Kan: "कन्नड",
+		Kat: "जॉर्जियन",
+		Khm: "ख्मेर",
+		Kin: "किन्यार्वान्डा",
+		Kor: "कोरियन",
+		Kur: "कुर्दिश",
+		Lav: "लात्व्हियन",
+		Lit: "लिथुआनियन",
+		Mai: "मैथिली",
+		Mal: "मल्याळम",
+		Mar: "मराठी",
+		Mkd: "मॅसेडोनियन",
+		Mlg: "मलागसी",
+		Mya: "बर्मी",
+		Nep: "नेपाळी",
+		Nld: "डच",
+		Nno: "नॉर्वेजियन न्योर्स्क",
+		Nob: "नॉर्वेजियन बोकमाल",
+		Nya: "न्यान्जा",
+		Ori: "उडिया",
+		Orm: "ओरोमो",
+		Pan: "पंजाबी",
+		Pes: "फारसी",
+		Pol: "पोलिश",
+		Por: "पोर्तुगीज",
+		Ron: "रोमानियन",
+		Run: "रुन्दी",
+		Rus: "रशियन",
+		Sin: "सिंहला",
+		Slv: "स्लोव्हेनियन",
+		Sna: "शोना",
+		Som: "सोमाली",
+		Spa: "स्पॅनिश",
+		Srp: "सर्बियन",
+		Swe: "स्वीडिश",
+		Tam: "तामिळ",
+		Tel: "तेलगू",
+		Tgl: "फिलिपिनो",
+		Tha: "थाई",
+		Tir: "तिग्रिन्या",
+		Tuk: "तुर्कमेन",
+		Tur: "तुर्की",
+		Uig: "उइगुर",
+		Ukr: "युक्रेनियन",
+		Urd: "उर्दू",
+		Uzb: "उझ्बेक",
+		Vie: "व्हिएतनामी",
+		Ydd: "यिद्दिश",
+		Yor: "योरुबा",
+		Zul: "झुलू",
+	},
+	Mkd: {
+		Afr: "африканс",
+		Aka: "акански",
+		Amh: "амхарски",
+		Arb: "арапски",
+		Azj: "азербејџански",
+		Bel: "белоруски",
+		Ben: "бенгалски",
+		Bho: "боџпури",
+		Bul: "бугарски",
+		Ceb: "себуански",
+		Ces: "чешки",
+		Cmn: "кинески",
+		Dan: "дански",
+		Deu: "германски",
+		Ell: "грчки",
+		Eng: "англиски",
+		Epo: "есперанто",
+		Est: "естонски",
+		Fin: "фински",
+		Fra: "француски",
+		Guj: "гуџарати",
+		Hat: "хаитски",
+		Hau: "хауса",
+		Heb: "хебрејски",
+		Hin: "хинди",
+		Hrv: "хрватски",
+		Hun: "унгарски",
+		Ibo: "игбо",
+		Ilo: "илокански",
+		Ind: "индонезиски",
+		Ita: "италијански",
+		Jav: "јавански",
+		Jpn: "јапонски",
+		Kan: "каннада",
+		Kat: "грузиски",
+		Khm: "кмерски",
+		Kin: "руандски",
+		Kor: "корејски",
+		Kur: "курдски",
+		Lav: "латвиски",
+		Lit: "литвански",
+		Mai: "маитили",
+		Mal: "малајамски",
+		Mar: "марати",
+		Mkd: "македонски",
+		Mlg: "малгашки",
+		Mya: "бурмански",
+		Nep: "непалски",
+		Nld: "холандски",
+		Nno: "норвешки нинорск",
+		Nob: "норвешки букмол",
+		Nya: "њанџа",
+		Ori: "одија",
+		Orm: "оромо",
+		Pan: "пенџапски",
+		Pes: "персиски",
+		Pol: "полски",
+		Por: "португалски",
+		Ron: "романски",
+		Run: "рунди",
+		Rus: "руски",
+		Sin: "синхалски",
+		Slv: "словенечки",
+		Sna: "шона",
+		Som: "сомалиски",
+		Spa: "шпански",
+		Srp: "српски",
+		Swe: "шведски",
+		Tam: "тамилски",
+		Tel: "телугу",
+		Tgl: "филипински",
+		Tha: "тајландски",
+		Tir: "тигриња",
+		Tuk: "туркменски",
+		Tur: "турски",
+		Uig: "ујгурски",
+		Ukr: "украински",
+		Urd: "урду",
+		Uzb: "узбечки",
+		Vie: "виетнамски",
+		Ydd: "јидиш",
+		Yor: "јорупски",
+		Zul: "зулу",
+	},
+	Mlg: {
+		Aka: "Akan",
+		Amh: "Amharika",
+		Arb: "Arabo",
+		Bel: "Bielorosy",
+		Ben: "Bengali",
+		Bul: "Biolgara",
+		Ces: "Tseky",
+		Cmn: "Sinoa, Mandarin",
+		Deu: "Alemanina",
+		Ell: "Grika",
+		Eng: "Anglisy",
+		Fra: "Frantsay",
+		Hau: "haoussa",
+		Hin: "hindi",
+		Hun: "hongroà",
+		Ibo: "igbo",
+		Ind: "Indonezianina",
+		Ita: "Italianina",
+		Jav: "Javaney",
+		Jpn: "Japoney",
+		Khm: "khmer",
+		Kin: "Roande",
+		Kor: "Koreanina",
+		Mlg: "Malagasy",
+		Mya: "Birmana",
+		Nep: "Nepale",
+		Nld: "Holandey",
+		Pan: "Penjabi",
+		Pes: "Persa",
+		Pol: "Poloney",
+		Por: "Portiogey",
+		Ron: "Romanianina",
+		Rus: "Rosianina",
+		Som: "Somalianina",
+		Spa: "Espaniola",
+		Swe: "Soisa",
+		Tam: "Tamoila",
+		Tha: "Taioaney",
+		Tur: "Tiorka",
+		Ukr: "Okrainianina",
+		Urd: "Ordò",
+		Vie: "Vietnamianina",
+		Yor: "Yôrobà",
+		Zul: "Zolò",
+	},
+	Mya: {
+		Afr: "တောင်အာဖရိက",
+		Aka: "အာကန်",
+		Amh: "အမ်ဟာရစ်ခ်",
+		Arb: "အာရဗီ",
+		Azj: "အဇာဘိုင်ဂျန်",
+		Bel: "ဘီလာရုစ်",
+		Ben: "ဘင်္ဂါလီ",
+		Bho: "ဘို့ဂျ်ပူရီ",
+		Bul: "ဘူလ်ဂေးရီးယား",
+		Ceb: "စီဗူအာနို",
+		Ces: "ချက်",
+		Cmn: "တရုတ်",
+		Dan: "ဒိန်းမတ်",
+		Deu: "ဂျာမန်",
+		Ell: "ဂရိ",
+		Eng: "အင်္ဂလိပ်",
+		Epo: "အက်စ်ပရန်တို",
+		Est: "အက်စ်တိုးနီးယား",
+		Fin: "ဖင်လန်",
+		Fra: "ပြင်သစ်",
+		Guj: "ဂူဂျာရသီ",
+		Hat: "ဟေတီ",
+		Hau: "ဟာဥစာ",
+		Heb: "ဟီးဘရူး",
+		Hin: "ဟိန္ဒူ",
+		Hrv: "ခရိုအေးရှား",
+		Hun: "ဟန်ဂေရီ",
+		Ibo: "အစ္ဂဘို",
+		Ilo: "အီလိုကို",
+		Ind: "အင်ဒိုနီးရှား",
+		Ita: "အီတလီ",
+		Jav: "ဂျာဗား",
+		Jpn: "ဂျပန်",
+		Kan: "ကန္နာဒါ",
+		Kat: "ဂျော်ဂျီယာ",
+		Khm: "ခမာ",
+		Kin: "ကင်ရာဝန်ဒါ",
+		Kor: "ကိုရီးယား",
+		Kur: "ကဒ်",
+		Lav: "လတ်ဗီးယား",
+		Lit: "လစ်သူဝေးနီးယား",
+		Mai: "မိုင်သီလီ",
+		Mal: "မလေယာလမ်",
+		Mar: "မာရသီ",
+		Mkd: "မက်ဆီဒိုးနီးယား",
+		Mlg: "မာလဂက်စီ",
+		Mya: "မြန်မာ",
+		Nep: "နီပေါ",
+		Nld: "ဒတ်ခ်ျ",
+		Nno: "နော်ဝေ နီးနောစ်",
+		Nob: "နော်ဝေ ဘွတ်ခ်မော်လ်",
+		Nya: "နရန်ဂျာ",
+		Ori: "အိုရီရာ",
+		Orm: "အိုရိုမို",
+		Pan: "ပန်ချာပီ",
+		Pes: "ပါရှန်",
+		Pol: "ပိုလန်",
+		Por: "ပေါ်တူဂီ",
+		Ron: "ရိုမေနီယား",
+		Run: "ရွန်ဒီ",
+		Rus: "ရုရှ",
+		Sin: "စင်ဟာလာ",
+		Slv: "ဆလိုဗေးနီးယား",
+		Sna: "ရှိုနာ",
+		Som: "ဆိုမာလီ",
+		Spa: "စပိန်",
+		Srp: "ဆားဘီးယား",
+		Swe: "ဆွီဒင်",
+		Tam: "တမီးလ်",
+		Tel: "တီလီဂူ",
+		Tgl: "ဖိလစ်ပိုင်",
+		Tha: "ထိုင်း",
+		Tir: "တီဂ်ရင်ယာ",
+		Tuk: "တာ့ခ်မင်နစ္စတန်",
+		Tur: "တူရကီ",
+		Uig: "ဝီဂါ",
+		Ukr: "ယူကရိန်း",
+		Urd: "အူရ်ဒူ",
+		Uzb: "ဥဇဘတ်",
+		Vie: "ဗီယက်နမ်",
+		Ydd: "ဂျူး",
+		Yor: "ယိုရူဘာ",
+		Zul: "ဇူးလူး",
+	},
+	Nep: {
+		Afr: "अफ्रिकान्स",
+		Aka: "आकान",
+		Amh: "अम्हारिक",
+		Arb: "अरबी",
+		Azj: "अजरबैजानी",
+		Bel: "बेलारुसी",
+		Ben: "बंगाली",
+		Bho: "भोजपुरी",
+		Bul: "बुल्गेरियाली",
+		Ceb: "सेबुआनो",
+		Ces: "चेक",
+		Cmn: "चिनियाँ",
+		Dan: "डेनिस",
+		Deu: "जर्मन",
+		Ell: "ग्रीक",
+		Eng: "अङ्ग्रेजी",
+		Epo: "एस्पेरान्तो",
+		Est: "इस्टोनियन",
+		Fin: "फिनिस",
+		Fra: "फ्रान्सेली",
+		Guj: "गुजराती",
+		Hat: "हैटियाली क्रियोल",
+		Hau: "हाउसा",
+		Heb: "हिब्रु",
+		Hin: "हिन्दी",
+		Hrv: "क्रोयसियाली",
+		Hun: "हङ्गेरियाली",
+		Ibo: "इग्बो",
+		Ilo: "इयोको",
+		Ind: "इन्डोनेसियाली",
+		Ita: "इटालेली",
+		Jav: "जाभानी",
+		Jpn: "जापानी",
+		Kan: "कन्नाडा",
+		Kat: "जर्जियाली",
+		Khm: "खमेर",
+		Kin: "किन्यारवान्डा",
+		Kor: "कोरियाली",
+		Kur: "कुर्दी",
+		Lav: "लात्भियाली",
+		Lit: "लिथुआनियाली",
+		Mai: "मैथिली",
+		Mal: "मलयालम",
+		Mar: "मराठी",
+		Mkd: "म्यासेडोनियन",
+		Mlg: "मलागासी",
+		Mya: "बर्मेली",
+		Nep: "नेपाली",
+		Nld: "डच",
+		Nno: "नर्वेली नाइनोर्स्क",
+		Nob: "नर्वेली बोकमाल",
+		Nya: "न्यान्जा",
+		Ori: "उडिया",
+		Orm: "ओरोमो",
+		Pan: "पंजाबी",
+		Pes: "फारसी",
+		Pol: "पोलिस",
+		Por: "पोर्तुगी",
+		Ron: "रोमानियाली",
+		Run: "रुन्डी",
+		Rus: "रसियाली",
+		Sin: "सिन्हाली",
+		Slv: "स्लोभेनियाली",
+		Sna: "शोना",
+		Som: "सोमाली",
+		Spa: "स्पेनी",
+		Srp: "सर्बियाली",
+		Swe: "स्विडिस",
+		Tam: "तामिल",
+		Tel: "तेलुगु",
+		Tgl: "फिलिपिनी",
+		Tha: "थाई",
+		Tir: "टिग्रिन्या",
+		Tuk: "टर्कमेन",
+		Tur: "टर्किश",
+		Uig: "उइघुर",
+		Ukr: "युक्रेनी",
+		Urd: "उर्दु",
+		Uzb: "उज्बेकी",
+		Vie: "भियतनामी",
+		Ydd: "यिद्दिस",
+		Yor: "योरूवा",
+		Zul: "जुलु",
+	},
+	Nld: {
+		Afr: "Afrikaans",
+		Aka: "Akan",
+		Amh: "Amhaars",
+		Arb: "Arabisch",
+		Azj: "Azerbeidzjaans",
+		Bel: "Wit-Russisch",
+		Ben: "Bengaals",
+		Bho: "Bhojpuri",
+		Bul: "Bulgaars",
+		Ceb: "Cebuano",
+		Ces: "Tsjechisch",
+		Cmn: "Chinees",
+		Dan: "Deens",
+		Deu: "Duits",
+		Ell: "Grieks",
+		Eng: "Engels",
+		Epo: "Esperanto",
+		Est: "Estisch",
+		Fin: "Fins",
+		Fra: "Frans",
+		Guj: "Gujarati",
+		Hat: "Haïtiaans Creools",
+		Hau: "Hausa",
+		Heb: "Hebreeuws",
+		Hin: "Hindi",
+		Hrv: "Kroatisch",
+		Hun: "Hongaars",
+		Ibo: "Igbo",
+		Ilo: "Iloko",
+		Ind: "Indonesisch",
+		Ita: "Italiaans",
+		Jav: "Javaans",
+		Jpn: "Japans",
+		Kan: "Kannada",
+		Kat: "Georgisch",
+		Khm: "Khmer",
+		Kin: "Kinyarwanda",
+		Kor: "Koreaans",
+		Kur: "Koerdisch",
+		Lav: "Lets",
+		Lit: "Litouws",
+		Mai: "Maithili",
+		Mal: "Malayalam",
+		Mar: "Marathi",
+		Mkd: "Macedonisch",
+		Mlg: "Malagassisch",
+		Mya: "Birmaans",
+		Nep: "Nepalees",
+		Nld: "Nederlands",
+		Nno: "Noors - Nynorsk",
+		Nob: "Noors - Bokmål",
+		Nya: "Nyanja",
+		Ori: "Odia",
+		Orm: "Afaan Oromo",
+		Pan: "Punjabi",
+		Pes: "Perzisch",
+		Pol: "Pools",
+		Por: "Portugees",
+		Ron: "Roemeens",
+		Run: "Kirundi",
+		Rus: "Russisch",
+		Sin: "Singalees",
+		Slv: "Sloveens",
+		Sna: "Shona",
+		Som: "Somalisch",
+		Spa: "Spaans",
+		Srp: "Servisch",
+		Swe: "Zweeds",
+		Tam: "Tamil",
+		Tel: "Telugu",
+		Tgl: "Filipijns",
+		Tha: "Thai",
+		Tir: "Tigrinya",
+		Tuk: "Turkmeens",
+		Tur: "Turks",
+		Uig: "Oeigoers",
+		Ukr: "Oekraïens",
+		Urd: "Urdu",
+		Uzb: "Oezbeeks",
+		Vie: "Vietnamees",
+		Ydd: "Jiddisch",
+		Yor: "Yoruba",
+		Zul: "Zoeloe",
+	},
+	Nno: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amharisk",
+		Arb: "arabisk",
+		Azj: "aserbajdsjansk",
+		Bel: "kviterussisk",
+		Ben: "bengali",
+		Bho: "bhojpuri",
+		Bul: "bulgarsk",
+		Ceb: "cebuano",
+		Ces: "tsjekkisk",
+		Cmn: "kinesisk",
+		Dan: "dansk",
+		Deu: "tysk",
+		Ell: "gresk",
+		Eng: "engelsk",
+		Epo: "esperanto",
+		Est: "estisk",
+		Fin: "finsk",
+		Fra: "fransk",
+		Guj: "gujarati",
+		Hat: "haitisk",
+		Hau: "hausa",
+		Heb: "hebraisk",
+		Hin: "hindi",
+		Hrv: "kroatisk",
+		Hun: "ungarsk",
+		Ibo: "ibo",
+		Ilo: "iloko",
+		Ind: "indonesisk",
+		Ita: "italiensk",
+		Jav: "javanesisk",
+		Jpn: "japansk",
+		Kan: "kannada",
+		Kat: "georgisk",
+		Khm: "khmer",
+		Kin: "kinjarwanda",
+		Kor: "koreansk",
+		Kur: "kurdisk",
+		Lav: "latvisk",
+		Lit: "litauisk",
+		Mai: "maithili",
+		Mal: "malayalam",
+		Mar: "marathi",
+		Mkd: "makedonsk",
+		Mlg: "madagassisk",
+		Mya: "burmesisk",
+		Nep: "nepalsk",
+		Nld: "nederlandsk",
+		Nno: "nynorsk",
+		Nob: "bokmål",
+		Nya: "nyanja",
+		Ori: "odia",
+		Orm: "oromo",
+		Pan: "panjabi",
+		Pes: "persisk",
+		Pol: "polsk",
+		Por: "portugisisk",
+		Ron: "rumensk",
+		Run: "rundi",
+		Rus: "russisk",
+		Sin: "singalesisk",
+		Slv: "slovensk",
+		Sna: "shona",
+		Som: "somali",
+		Spa: "spansk",
+		Srp: "serbisk",
+		Swe: "svensk",
+		Tam: "tamil",
+		Tel: "telugu",
+		Tgl: "filippinsk",
+		Tha: "thai",
+		Tir: "tigrinja",
+		Tuk: "turkmensk",
+		Tur: "tyrkisk",
+		Uig: "uigurisk",
+		Ukr: "ukrainsk",
+		Urd: "urdu",
+		Uzb: "usbekisk",
+		Vie: "vietnamesisk",
+		Ydd: "jiddisk",
+		Yor: "joruba",
+		Zul: "zulu",
+	},
+	Nob: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amharisk",
+		Arb: "arabisk",
+		Azj: "aserbajdsjansk",
+		Bel: "hviterussisk",
+		Ben: "bengali",
+		Bho: "bhojpuri",
+		Bul: "bulgarsk",
+		Ceb: "cebuansk",
+		Ces: "tsjekkisk",
+		Cmn: "kinesisk",
+		Dan: "dansk",
+		Deu: "tysk",
+		Ell: "gresk",
+		Eng: "engelsk",
+		Epo: "esperanto",
+		Est: "estisk",
+		Fin: "finsk",
+		Fra: "fransk",
+		Guj: "gujarati",
+		Hat: "haitisk",
+		Hau: "hausa",
+		Heb: "hebraisk",
+		Hin: "hindi",
+		Hrv: "kroatisk",
+		Hun: "ungarsk",
+		Ibo: "ibo",
+		Ilo: "iloko",
+		Ind: "indonesisk",
+		Ita: "italiensk",
+		Jav: "javanesisk",
+		Jpn: "japansk",
+		Kan: "kannada",
+		Kat: "georgisk",
+		Khm: "khmer",
+		Kin: "kinyarwanda",
+		Kor: "koreansk",
+		Kur: "kurdisk",
+		Lav: "latvisk",
+		Lit: "litauisk",
+		Mai: "maithili",
+		Mal: "malayalam",
+		Mar: "marathi",
+		Mkd: "makedonsk",
+		Mlg: "gassisk",
+		Mya: "burmesisk",
+		Nep: "nepali",
+		Nld: "nederlandsk",
+		Nno: "norsk nynorsk",
+		Nob: "norsk bokmål",
+		Nya: "nyanja",
+		Ori: "odia",
+		Orm: "oromo",
+		Pan: "panjabi",
+		Pes: "persisk",
+		Pol: "polsk",
+		Por: "portugisisk",
+		Ron: "rumensk",
+		Run: "rundi",
+		Rus: "russisk",
+		Sin: "singalesisk",
+		Slv: "slovensk",
+		Sna: "shona",
+		Som: "somali",
+		Spa: "spansk",
+		Srp: "serbisk",
+		Swe: "svensk",
+		Tam: "tamil",
+		Tel: "telugu",
+		Tgl: "filipino",
+		Tha: "thai",
+		Tir: "tigrinja",
+		Tuk: "turkmensk",
+		Tur: "tyrkisk",
+		Uig: "uigurisk",
+		Ukr: "ukrainsk",
+		Urd: "urdu",
+		Uzb: "usbekisk",
+		Vie: "vietnamesisk",
+		Ydd: "jiddisk",
+		Yor: "joruba",
+		Zul: "zulu",
+	},
+	Ori: {
+		Afr: "ଆଫ୍ରିକୀୟ",
+		Aka: "ଅକନ୍",
+		Amh: "ଆମହାରକି",
+		Arb: "ଆରବିକ୍",
+		Azj: "ଆଜେରବାଇଜାନି",
+		Bel: "ବେଲାରୁଷିଆନ୍",
+		Ben: "ବଙ୍ଗାଳୀ",
+		Bho: "ଭୋଜପୁରୀ",
+		Bul: "ବୁଲଗେରିଆନ୍",
+		Ceb: "ସୀବୁଆନୋ",
+		Ces: "ଚେକ୍",
+		Cmn: "ଚାଇନିଜ୍\u200c",
+		Dan: "ଡାନ୍ନିସ୍",
+		Deu: "ଜର୍ମାନ",
+		Ell: "ଗ୍ରୀକ୍",
+		Eng: "ଇଂରାଜୀ",
+		Epo: "ଏସ୍ପାରେଣ୍ଟୋ",
+		Est: "ଏସ୍ତୋନିଆନ୍",
+		Fin: "ଫିନ୍ନିସ୍",
+		Fra: "ଫରାସୀ",
+		Guj: "ଗୁଜୁରାଟୀ",
+		Hat: "ହୈତାୟିନ୍",
+		Hau: "ହୌସା",
+		Heb: "ହେବ୍ର୍ୟୁ",
+		Hin: "ହିନ୍ଦୀ",
+		Hrv: "କ୍ରୋଆଟିଆନ୍",
+		Hun: "ହଙ୍ଗେରୀୟ",
+		Ibo: "ଇଗବୋ",
+		Ilo: "ଇଲୋକୋ",
+		Ind: "ଇଣ୍ଡୋନେସୀୟ",
+		Ita: "ଇଟାଲୀୟ",
+		Jav: "ଜାଭାନୀଜ୍",
+		Jpn: "ଜାପାନୀ",
+		Kan: "କନ୍ନଡ",
+		Kat: "ଜର୍ଜିୟ",
+		Khm: "ଖାମେର୍",
+		Kin: "କିନ୍ୟାରୱାଣ୍ଡା",
+		Kor: "କୋରିଆନ୍",
+		Kur: "କୁର୍ଦ୍ଦିଶ୍",
+		Lav: "ଲାଟଭିଆନ୍",
+		Lit: "ଲିଥୁଆନିଆନ୍",
+		Mai: "ମୈଥିଳୀ",
+		Mal: "ମାଲାୟଲମ୍",
+		Mar: "ମରାଠୀ",
+		Mkd: "ମାସେଡୋନିଆନ୍",
+		Mlg: "ମାଲାଗାସୀ",
+		Mya: "ବର୍ମୀଜ୍",
+		Nep: "ନେପାଳୀ",
+		Nld: "ଡଚ୍",
+		Nno: "ନରୱେଜିଆନ୍ ନିୟୋର୍ସ୍କ",
+		Nob: "ନରୱେଜିଆନ୍ ବୋକମଲ୍",
+		Nya: "ନିୟାଞ୍ଜ",
+		Ori: "ଓଡ଼ିଆ",
+		Orm: "ଓରୋମୋ",
+		Pan: "ପଞ୍ଜାବୀ",
+		Pes: "ପର୍ସିଆନ୍",
+		Pol: "ପୋଲିଶ୍",
+		Por: "ପର୍ତ୍ତୁଗୀଜ୍\u200c",
+		Ron: "ରୋମାନିଆନ୍",
+		Run: "ରୁଣ୍ଡି",
+		Rus: "ରୁଷିୟ",
+		Sin: "ସିଂହଳ",
+		Slv: "ସ୍ଲୋଭେନିଆନ୍",
+		Sna: "ଶୋନା",
+		Som: "ସୋମାଲିଆ",
+		Spa: "ସ୍ପେନିୟ",
+		Srp: "ସର୍ବିୟ",
+		Swe: "ସ୍ୱେଡିସ୍",
+		Tam: "ତାମିଲ୍",
+		Tel: "ତେଲୁଗୁ",
+		Tgl: "ଫିଲିପିନୋ",
+		Tha: "ଥାଇ",
+		Tir: "ଟ୍ରିଗିନିଆ",
+		Tuk: "ତୁର୍କମେନ୍",
+		Tur: "ତୁର୍କିସ୍",
+		Uig: "ୟୁଘୁର୍",
+		Ukr: "ୟୁକ୍ରାନିଆନ୍",
+		Urd: "ଉର୍ଦ୍ଦୁ",
+		Uzb: "ଉଜବେକ୍",
+		Vie: "ଭିଏତନାମିଜ୍",
+		Ydd: "ୟିଡିସ୍",
+		Yor: "ୟୋରୁବା",
+		Zul: "ଜୁଲୁ",
+	},
+	Orm: {
+		Afr: "Afrikoota",
+		Amh: "Afaan Sidaamaa",
+		Arb: "Arabiffaa",
+		Azj: "Afaan Azerbaijani",
+		Bel: "Afaan Belarusia",
+		Ben: "Afaan Baangladeshi",
+		Bul: "Afaan Bulgariya",
+		Ces: "Afaan Czech",
+		Cmn: "Chinese",
+		Dan: "Afaan Deenmaark",
+		Deu: "Afaan Jarmanii",
+		Ell: "Afaan Giriiki",
+		Eng: "Ingliffa",
+		Epo: "Afaan Esperantoo",
+		Est: "Afaan Istooniya",
+		Fin: "Afaan Fiilaandi",
+		Fra: "Afaan Faransaayii",
+		Guj: "Afaan Gujarati",
+		Heb: "Afaan Hebrew",
+		Hin: "Afaan Hindii",
+		Hrv: "Afaan Croatian",
+		Hun: "Afaan Hangaari",
+		Ind: "Afaan Indoneziya",
+		Ita: "Afaan Xaaliyaani",
+		Jav: "Afaan Java",
+		Jpn: "Afaan Japanii",
+		Kan: "Afaan Kannada",
+		Kat: "Afaan Georgian",
+		Kor: "Afaan Korea",
+		Lav: "Afaan Lativiyaa",
+		Lit: "Afaan Liituniyaa",
+		Mal: "Malayaalamiffaa",
+		Mar: "Afaan Maratii",
+		Mkd: "Afaan Macedooniyaa",
+		Nep: "Afaan Nepalii",
+		Nld: "Afaan Dachii",
+		Nno: "Afaan Norwegian",
+		Nob: "Afaan Norweyii",
+		Orm: "Oromoo",
+		Pan: "Afaan Punjabii",
+		Pes: "Afaan Persia",
+		Pol: "Afaan Polandii",
+		Por: "Afaan Porchugaal",
+		Ron: "Afaan Romaniyaa",
+		Rus: "Afaan Rushiyaa",
+		Sin: "Afaan Sinhalese",
+		Slv: "Afaan Islovaniyaa",
+		Spa: "Afaan Ispeen",
+		Srp: "Afaan Serbiya",
+		Swe: "Afaan Suwidiin",
+		Tam: "Afaan Tamilii",
+		Tel: "Afaan Telugu",
+		Tgl: "Afaan Filippinii",
+		Tha: "Afaan Tayii",
+		Tir: "Afaan Tigiree",
+		Tuk: "Lammii Turkii",
+		Tur: "Afaan Turkii",
+		Ukr: "Afaan Ukreenii",
+		Urd: "Afaan Urdu",
+		Uzb: "Afaan Uzbek",
+		Vie: "Afaan Veetinam",
+		Zul: "Afaan Zuulu",
+	},
+	Pan: {
+		Afr: "ਅਫ਼ਰੀਕੀ",
+		Aka: "ਅਕਾਨ",
+		Amh: "ਅਮਹਾਰਿਕ",
+		Arb: "ਅਰਬੀ",
+		Azj: "ਅਜ਼ਰਬਾਈਜਾਨੀ",
+		Bel: "ਬੇਲਾਰੂਸੀ",
+		Ben: "ਬੰਗਾਲੀ",
+		Bho: "ਭੋਜਪੁਰੀ",
+		Bul: "ਬੁਲਗਾਰੀਆਈ",
+		Ceb: "ਸੀਬੂਆਨੋ",
+		Ces: "ਚੈੱਕ",
+		Cmn: "ਚੀਨੀ (ਮੈਂਡਰਿਨ)",
+		Dan: "ਡੈਨਿਸ਼",
+		Deu: "ਜਰਮਨ",
+		Ell: "ਯੂਨਾਨੀ",
+		Eng: "ਅੰਗਰੇਜ਼ੀ",
+		Epo: "ਇਸਪੇਰਾਂਟੋ",
+		Est: "ਇਸਟੋਨੀਆਈ",
+		Fin: "ਫਿਨਿਸ਼",
+		Fra: "ਫਰਾਂਸੀਸੀ",
+		Guj: "ਗੁਜਰਾਤੀ",
+		Hat: "ਹੈਤੀਆਈ",
+		Hau: "ਹੌਸਾ",
+		Heb: "ਹਿਬਰੂ",
+		Hin: "ਹਿੰਦੀ",
+		Hrv: "ਕ੍ਰੋਏਸ਼ਿਆਈ",
+		Hun: "ਹੰਗਰੀਆਈ",
+		Ibo: "ਇਗਬੋ",
+		Ilo: "ਇਲੋਕੋ",
+		Ind: "ਇੰਡੋਨੇਸ਼ੀਆਈ",
+		Ita: "ਇਤਾਲਵੀ",
+		Jav: "ਜਾਵਾਨੀਜ਼",
+		Jpn: "ਜਪਾਨੀ",
+		Kan: "ਕੰਨੜ",
+		Kat: "ਜਾਰਜੀਆਈ",
+		Khm: "ਖਮੇਰ",
+		Kin: "ਕਿਨਿਆਰਵਾਂਡਾ",
+		Kor: "ਕੋਰੀਆਈ",
+		Kur: "ਕੁਰਦ",
+		Lav: "ਲਾਤੀਵੀ",
+		Lit: "ਲਿਥੁਆਨੀਅਨ",
+		Mai: "ਮੈਥਲੀ",
+		Mal: "ਮਲਿਆਲਮ",
+		Mar: "ਮਰਾਠੀ",
+		Mkd: "ਮੈਕਡੋਨੀਆਈ",
+		Mlg: "ਮੇਲੇਗਸੀ",
+		Mya: "ਬਰਮੀ",
+		Nep: "ਨੇਪਾਲੀ",
+		Nld: "ਡੱਚ",
+		Nno: "ਨਾਰਵੇਜਿਆਈ ਨਿਓਨੌਰਸਕ",
+		Nob: "ਨਾਰਵੇਜਿਆਈ ਬੋਕਮਲ",
+		Nya: "ਨਯਾਂਜਾ",
+		Ori: "ਉੜੀਆ",
+		Orm: "ਓਰੋਮੋ",
+		Pan: "ਪੰਜਾਬੀ",
+		Pes: "ਫ਼ਾਰਸੀ",
+		Pol: "ਪੋਲੈਂਡੀ",
+		Por: "ਪੁਰਤਗਾਲੀ",
+		Ron: "ਰੋਮਾਨੀਆਈ",
+		Run: "ਰੁੰਡੀ",
+		Rus: "ਰੂਸੀ",
+		Sin: "ਸਿੰਹਾਲਾ",
+		Slv: "ਸਲੋਵੇਨੀਆਈ",
+		Sna: "ਸ਼ੋਨਾ",
+		Som: "ਸੋਮਾਲੀ",
+		Spa: "ਸਪੇਨੀ",
+		Srp: "ਸਰਬੀਆਈ",
+		Swe: "ਸਵੀਡਿਸ਼",
+		Tam: "ਤਮਿਲ",
+		Tel: "ਤੇਲਗੂ",
+		Tgl: "ਫਿਲੀਪਿਨੋ",
+		Tha: "ਥਾਈ",
+		Tir: "ਤਿਗ੍ਰੀਨਿਆ",
+		Tuk: "ਤੁਰਕਮੇਨ",
+		Tur: "ਤੁਰਕੀ",
+		Uig: "ਉਇਗੁਰ",
+		Ukr: "ਯੂਕਰੇਨੀਆਈ",
+		Urd: "ਉੜਦੂ",
+		Uzb: "ਉਜ਼ਬੇਕ",
+		Vie: "ਵੀਅਤਨਾਮੀ",
+		Ydd: "ਯਿਦਿਸ਼",
+		Yor: "ਯੋਰੂਬਾ",
+		Zul: "ਜ਼ੁਲੂ",
+	},
+	Pes: {
+		Afr: "آفریکانس",
+		Aka: "آکان",
+		Amh: "امهری",
+		Arb: "عربی",
+		Azj: "ترکی آذربایجانی",
+		Bel: "بلاروسی",
+		Ben: "بنگالی",
+		Bho: "بوجپوری",
+		Bul: "بلغاری",
+		Ceb: "سبویی",
+		Ces: "چکی",
+		Cmn: "چینی",
+		Dan: "دانمارکی",
+		Deu: "آلمانی",
+		Ell: "یونانی",
+		Eng: "انگلیسی",
+		Epo: "اسپرانتو",
+		Est: "استونیایی",
+		Fin: "فنلاندی",
+		Fra: "فرانسوی",
+		Guj: "گجراتی",
+		Hat: "هائیتیایی",
+		Hau: "هوسیایی",
+		Heb: "عبری",
+		Hin: "هندی",
+		Hrv: "کروات",
+		Hun: "مجاری",
+		Ibo: "ایگبویی",
+		Ilo: "ایلوکویی",
+		Ind: "اندونزیایی",
+		Ita: "ایتالیایی",
+		Jav: "جاوه\u200cای",
+		Jpn: "ژاپنی",
+		Kan: "کانارا",
+		Kat: "گرجی",
+		Khm: "خمری",
+		Kin: "کینیارواندایی",
+		Kor: "کره\u200cای",
+		Kur: "کردی",
+		Lav: "لتونیایی",
+		Lit: "لیتوانیایی",
+		Mai: "مایدیلی",
+		Mal: "مالایالامی",
+		Mar: "مراتی",
+		Mkd: "مقدونی",
+		Mlg: "مالاگاسیایی",
+		Mya: "برمه\u200cای",
+		Nep: "نپالی",
+		Nld: "هلندی",
+		Nno: "نروژی نی\u200cنُشک",
+		Nob: "نروژی بوک\u200cمُل",
+		Nya: "نیانجایی",
+		Ori: "اوریه\u200cای",
+		Orm: "اورومویی",
+		Pan: "پنجابی",
+		Pes: "فارسی",
+		Pol: "لهستانی",
+		Por: "پرتغالی",
+		Ron: "رومانیایی",
+		Run: "روندیایی",
+		Rus: "روسی",
+		Sin: "سینهالی",
+		Slv: "اسلوونیایی",
+		Sna: "شونایی",
+		Som: "سومالیایی",
+		Spa: "اسپانیایی",
+		Srp: "صربی",
+		Swe: "سوئدی",
+		Tam: "تامیلی",
+		Tel: "تلوگویی",
+		Tgl: "فیلیپینی",
+		Tha: "تایلندی",
+		Tir: "تیگرینیایی",
+		Tuk: "ترکمنی",
+		Tur: "ترکی استانبولی",
+		Uig: "اویغوری",
+		Ukr: "اوکراینی",
+		Urd: "اردو",
+		Uzb: "ازبکی",
+		Vie: "ویتنامی",
+		Ydd: "یدی",
+		Yor: "یوروبایی",
+		Zul: "زولویی",
+	},
+	Pol: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amharski",
+		Arb: "arabski",
+		Azj: "azerbejdżański",
+		Bel: "białoruski",
+		Ben: "bengalski",
+		Bho: "bhodźpuri",
+		Bul: "bułgarski",
+		Ceb: "cebuano",
+		Ces: "czeski",
+		Cmn: "chiński",
+		Dan: "duński",
+		Deu: "niemiecki",
+		Ell: "grecki",
+		Eng: "angielski",
+		Epo: "esperanto",
+		Est: "estoński",
+		Fin: "fiński",
+		Fra: "francuski",
+		Guj: "gudżarati",
+		Hat: "kreolski haitański",
+		Hau: "hausa",
+		Heb: "hebrajski",
+		Hin: "hindi",
+		Hrv: "chorwacki",
+		Hun: "węgierski",
+		Ibo: "igbo",
+		Ilo: "ilokano",
+		Ind: "indonezyjski",
+		Ita: "włoski",
+		Jav: "jawajski",
+		Jpn: "japoński",
+		Kan: "kannada",
+		Kat: "gruziński",
+		Khm: "khmerski",
+		Kin: "kinya-ruanda",
+		Kor: "koreański",
+		Kur: "kurdyjski",
+		Lav: "łotewski",
+		Lit: "litewski",
+		Mai: "maithili",
+		Mal: "malajalam",
+		Mar: "marathi",
+		Mkd: "macedoński",
+		Mlg: "malgaski",
+		Mya: "birmański",
+		Nep: "nepalski",
+		Nld: "niderlandzki",
+		Nno: "norweski (nynorsk)",
+		Nob: "norweski (bokmål)",
+		Nya: "njandża",
+		Ori: "orija",
+		Orm: "oromo",
+		Pan: "pendżabski",
+		Pes: "perski",
+		Pol: "polski",
+		Por: "portugalski",
+		Ron: "rumuński",
+		Run: "rundi",
+		Rus: "rosyjski",
+		Sin: "syngaleski",
+		Slv: "słoweński",
+		Sna: "shona",
+		Som: "somalijski",
+		Spa: "hiszpański",
+		Srp: "serbski",
+		Swe: "szwedzki",
+		Tam: "tamilski",
+		Tel: "telugu",
+		Tgl: "filipino",
+		Tha: "tajski",
+		Tir: "tigrinia",
+		Tuk: "turkmeński",
+		Tur: "turecki",
+		Uig: "ujgurski",
+		Ukr: "ukraiński",
+		Urd: "urdu",
+		Uzb: "uzbecki",
+		Vie: "wietnamski",
+		Ydd: "jidysz",
+		Yor: "joruba",
+		Zul: "zulu",
+	},
+	Por: {
+		Afr: "africâner",
+		Aka: "akan",
+		Amh: "amárico",
+		Arb: "árabe",
+		Azj: "azerbaijano",
+		Bel: "bielorrusso",
+		Ben: "bengali",
+		Bho: "bhojpuri",
+		Bul: "búlgaro",
+		Ceb: "cebuano",
+		Ces: "tcheco",
+		Cmn: "chinês",
+		Dan: "dinamarquês",
+		Deu: "alemão",
+		Ell: "grego",
+		Eng: "inglês",
+		Epo: "esperanto",
+		Est: "estoniano",
+		Fin: "finlandês",
+		Fra: "francês",
+		Guj: "guzerate",
+		Hat: "haitiano",
+		Hau: "hauçá",
+		Heb: "hebraico",
+		Hin: "híndi",
+		Hrv: "croata",
+		Hun: "húngaro",
+		Ibo: "igbo",
+		Ilo: "ilocano",
+		Ind: "indonésio",
+		Ita: "italiano",
+		Jav: "javanês",
+		Jpn: "japonês",
+		Kan: "canarim",
+		Kat: "georgiano",
+		Khm: "khmer",
+		Kin: "quiniaruanda",
+		Kor: "coreano",
+		Kur: "curdo",
+		Lav: "letão",
+		Lit: "lituano",
+		Mai: "maithili",
+		Mal: "malaiala",
+		Mar: "marati",
+		Mkd: "macedônio",
+		Mlg: "malgaxe",
+		Mya: "birmanês",
+		Nep: "nepalês",
+		Nld: "holandês",
+		Nno: "nynorsk norueguês",
+		Nob: "bokmål norueguês",
+		Nya: "nianja",
+		Ori: "oriá",
+		Orm: "oromo",
+		Pan: "panjabi",
+		Pes: "persa",
+		Pol: "polonês",
+		Por: "português",
+		Ron: "romeno",
+		Run: "rundi",
+		Rus: "russo",
+		Sin: "cingalês",
+		Slv: "esloveno",
+		Sna: "xona",
+		Som: "somali",
+		Spa: "espanhol",
+		Srp: "sérvio",
+		Swe: "sueco",
+		Tam: "tâmil",
+		Tel: "télugo",
+		Tgl: "filipino",
+		Tha: "tailandês",
+		Tir: "tigrínia",
+		Tuk: "turcomeno",
+		Tur: "turco",
+		Uig: "uigur",
+		Ukr: "ucraniano",
+		Urd: "urdu",
+		Uzb: "uzbeque",
+		Vie: "vietnamita",
+		Ydd: "iídiche",
+		Yor: "iorubá",
+		Zul: "zulu",
+	},
+	Ron: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amharică",
+		Arb: "arabă",
+		Azj: "azeră",
+		Bel: "bielorusă",
+		Ben: "bengaleză",
+		Bho: "bhojpuri",
+		Bul: "bulgară",
+		Ceb: "cebuană",
+		Ces: "cehă",
+		Cmn: "chineză",
+		Dan: "daneză",
+		Deu: "germană",
+		Ell: "greacă",
+		Eng: "engleză",
+		Epo: "esperanto",
+		Est: "estonă",
+		Fin: "finlandeză",
+		Fra: "franceză",
+		Guj: "gujarati",
+		Hat: "haitiană",
+		Hau: "hausa",
+		Heb: "ebraică",
+		Hin: "hindi",
+		Hrv: "croată",
+		Hun: "maghiară",
+		Ibo: "igbo",
+		Ilo: "iloko",
+		Ind: "indoneziană",
+		Ita: "italiană",
+		Jav: "javaneză",
+		Jpn: "japoneză",
+		Kan: "kannada",
+		Kat: "georgiană",
+		Khm: "khmeră",
+		Kin: "kinyarwanda",
+		Kor: "coreeană",
+		Kur: "kurdă",
+		Lav: "letonă",
+		Lit: "lituaniană",
+		Mai: "maithili",
+		Mal: "malayalam",
+		Mar: "marathi",
+		Mkd: "macedoneană",
+		Mlg: "malgașă",
+		Mya: "birmană",
+		Nep: "nepaleză",
+		Nld: "neerlandeză",
+		Nno: "norvegiană nynorsk",
+		Nob: "norvegiană bokmål",
+		Nya: "nyanja",
+		Ori: "odia",
+		Orm: "oromo",
+		Pan: "punjabi",
+		Pes: "persană",
+		Pol: "poloneză",
+		Por: "portugheză",
+		Ron: "română",
+		Run: "kirundi",
+		Rus: "rusă",
+		Sin: "singhaleză",
+		Slv: "slovenă",
+		Sna: "shona",
+		Som: "somaleză",
+		Spa: "spaniolă",
+		Srp: "sârbă",
+		Swe: "suedeză",
+		Tam: "tamilă",
+		Tel: "telugu",
+		Tgl: "filipineză",
+		Tha: "thailandeză",
+		Tir: "tigrină",
+		Tuk: "turkmenă",
+		Tur: "turcă",
+		Uig: "uigură",
+		Ukr: "ucraineană",
+		Urd: "urdu",
+		Uzb: "uzbecă",
+		Vie: "vietnameză",
+		Ydd: "idiș",
+		Yor: "yoruba",
+		Zul: "zulu",
+	},
+	Run: {
+		Aka: "Igikani",
+		Amh: "Ikimuhariki",
+		Arb: "Icarabu",
+		Bel: "Ikibelarusiya",
+		Ben: "Ikibengali",
+		Bul: "Ikinyabuligariya",
+		Ces: "Igiceke",
+		Cmn: "Igishinwa",
+		Deu: "Ikidage",
+		Ell: "Ikigereki",
+		Eng: "Icongereza",
+		Fra: "Igifaransa",
+		Hau: "Igihawusa",
+		Hin: "Igihindi",
+		Hun: "Ikinyahongiriya",
+		Ibo: "Ikigubo",
+		Ind: "Ikinyendoziya",
+		Ita: "Igitaliyani",
+		Jav: "Ikinyejava",
+		Jpn: "Ikiyapani",
+		Khm: "Igikambodiya",
+		Kin: "Ikinyarwanda",
+		Kor: "Ikinyakoreya",
+		Mya: "Ikinyabirimaniya",
+		Nep: "Ikinepali",
+		Nld: "Igiholandi",
+		Pan: "Igipunjabi",
+		Pes: "Igiperisi",
+		Pol: "Ikinyapolonye",
+		Por: "Igiporutugari",
+		Ron: "Ikinyarumaniya",
+		Run: "Ikirundi",
+		Rus: "Ikirusiya",
+		Som: "Igisomali",
+		Spa: "Icesipanyolo",
+		Swe: "Igisuweduwa",
+		Tam: "Igitamili",
+		Tha: "Ikinyatayilandi",
+		Tur: "Igiturukiya",
+		Ukr: "Ikinyayukereni",
+		Urd: "Inyeyurudu",
+		Vie: "Ikinyaviyetinamu",
+		Yor: "Ikiyoruba",
+		Zul: "Ikizulu",
+	},
+	Rus: {
+		Afr: "африкаанс",
+		Aka: "акан",
+		Amh: "амхарский",
+		Arb: "арабский",
+		Azj: "азербайджанский",
+		Bel: "белорусский",
+		Ben: "бенгальский",
+		Bho: "бходжпури",
+		Bul: "болгарский",
+		Ceb: "себуано",
+		Ces: "чешский",
+		Cmn: "китайский",
+		Dan: "датский",
+		Deu: "немецкий",
+		Ell: "греческий",
+		Eng: "английский",
+		Epo: "эсперанто",
+		Est: "эстонский",
+		Fin: "финский",
+		Fra: "французский",
+		Guj: "гуджарати",
+		Hat: "гаитянский",
+		Hau: "хауса",
+		Heb: "иврит",
+		Hin: "хинди",
+		Hrv: "хорватский",
+		Hun: "венгерский",
+		Ibo: "игбо",
+		Ilo: "илоко",
+		Ind: "индонезийский",
+		Ita: "итальянский",
+		Jav: "яванский",
+		Jpn: "японский",
+		Kan: "каннада",
+		Kat: "грузинский",
+		Khm: "кхмерский",
+		Kin: "киньяруанда",
+		Kor: "корейский",
+		Kur: "курдский",
+		Lav: "латышский",
+		Lit: "литовский",
+		Mai: "майтхили",
+		Mal: "малаялам",
+		Mar: "маратхи",
+		Mkd: "македонский",
+		Mlg: "малагасийский",
+		Mya: "бирманский",
+		Nep: "непальский",
+		Nld: "нидерландский",
+		Nno: "нюнорск",
+		Nob: "норвежский букмол",
+		Nya: "ньянджа",
+		Ori: "ория",
+		Orm: "оромо",
+		Pan: "панджаби",
+		Pes: "персидский",
+		Pol: "польский",
+		Por: "португальский",
+		Ron: "румынский",
+		Run: "рунди",
+		Rus: "русский",
+		Sin: "сингальский",
+		Slv: "словенский",
+		Sna: "шона",
+		Som: "сомали",
+		Spa: "испанский",
+		Srp: "сербский",
+		Swe: "шведский",
+		Tam: "тамильский",
+		Tel: "телугу",
+		Tgl: "филиппинский",
+		Tha: "тайский",
+		Tir: "тигринья",
+		Tuk: "туркменский",
+		Tur: "турецкий",
+		Uig: "уйгурский",
+		Ukr: "украинский",
+		Urd: "урду",
+		Uzb: "узбекский",
+		Vie: "вьетнамский",
+		Ydd: "идиш",
+		Yor: "йоруба",
+		Zul: "зулу",
+	},
+	Sin: {
+		Afr: "අෆ්රිකාන්ස්",
+		Aka: "අකාන්",
+		Amh: "ඇම්හාරික්",
+		Arb: "අරාබි",
+		Azj: "අසර්බයිජාන්",
+		Bel: "බෙලරුසියානු",
+		Ben: "බෙංගාලි",
+		Bho: "බොජ්පුරි",
+		Bul: "බල්ගේරියානු",
+		Ceb: "සෙබුඅනො",
+		Ces: "චෙක්",
+		Cmn: "චීන",
+		Dan: "ඩැනිශ්",
+		Deu: "ජර්මන්",
+		Ell: "ග්\u200dරීක",
+		Eng: "ඉංග්\u200dරීසි",
+		Epo: "එස්පැරන්ටෝ",
+		Est: "එස්තෝනියානු",
+		Fin: "ෆින්ලන්ත",
+		Fra: "ප්\u200dරංශ",
+		Guj: "ගුජරාටි",
+		Hat: "හයිටි",
+		Hau: "හෝසා",
+		Heb: "හීබෲ",
+		Hin: "හින්දි",
+		Hrv: "කෝඒෂියානු",
+		Hun: "හන්ගේරියානු",
+		Ibo: "ඉග්බෝ",
+		Ilo: "ඉලොකො",
+		Ind: "ඉන්දුනීසියානු",
+		Ita: "ඉතාලි",
+		Jav: "ජාවා",
+		Jpn: "ජපන්",
+		Kan: "කණ්ණඩ",
+		Kat: "ජෝර්ජියානු",
+		Khm: "කමර්",
+		Kin: "කින්යර්වන්ඩා",
+		Kor: "කොරියානු",
+		Kur: "කුර්දි",
+		Lav: "ලැට්වියානු",
+		Lit: "ලිතුවේනියානු",
+		Mai: "මයිතිලි",
+		Mal: "මලයාලම්",
+		Mar: "මරාති",
+		Mkd: "මැසිඩෝනියානු",
+		Mlg: "මලගාසි",
+		Mya: "බුරුම",
+		Nep: "නේපාල",
+		Nld: "ලන්දේසි",
+		Nno: "නෝර්වීජියානු නයිනෝර්ස්ක්",
+		Nob: "නෝර්වීජියානු බොක්මල්",
+		Nya: "න්යන්ජා",
+		Ori: "ඔරියා",
+		Orm: "ඔරොමෝ",
+		Pan: "පන්ජාබි",
+		Pes: "පර්සියානු",
+		Pol: "පෝලන්ත",
+		Por: "පෘතුගීසි",
+		Ron: "රොමේනියානු",
+		Run: "රුන්ඩි",
+		Rus: "රුසියානු",
+		Sin: "සිංහල",
+		Slv: "ස්ලෝවේනියානු",
+		Sna: "ශෝනා",
+		Som: "සෝමාලි",
+		Spa: "ස්පාඤ්ඤ",
+		Srp: "සර්බියානු",
+		Swe: "ස්වීඩන්",
+		Tam: "දෙමළ",
+		Tel: "තෙළිඟු",
+		Tgl: "පිලිපීන",
+		Tha: "තායි",
+		Tir: "ටිග්\u200dරින්යා",
+		Tuk: "ටර්ක්මෙන්",
+		Tur: "තුර්කි",
+		Uig: "උයිගර්",
+		Ukr: "යුක්රේනියානු",
+		Urd: "උර්දු",
+		Uzb: "උස්බෙක්",
+		Vie: "වියට්නාම්",
+		Ydd: "යිඩිශ්",
+		Yor: "යොරූබා",
+		Zul: "සුලු",
+	},
+	Slv: {
+		Afr: "afrikanščina",
+		Aka: "akanščina",
+		Amh: "amharščina",
+		Arb: "arabščina",
+		Azj: "azerbajdžanščina",
+		Bel: "beloruščina",
+		Ben: "bengalščina",
+		Bho: "bodžpuri",
+		Bul: "bolgarščina",
+		Ceb: "sebuanščina",
+		Ces: "češčina",
+		Cmn: "kitajščina",
+		Dan: "danščina",
+		Deu: "nemščina",
+		Ell: "grščina",
+		Eng: "angleščina",
+		Epo: "esperanto",
+		Est: "estonščina",
+		Fin: "finščina",
+		Fra: "francoščina",
+		Guj: "gudžaratščina",
+		Hat: "haitijska kreolščina",
+		Hau: "havščina",
+		Heb: "hebrejščina",
+		Hin: "hindujščina",
+		Hrv: "hrvaščina",
+		Hun: "madžarščina",
+		Ibo: "igboščina",
+		Ilo: "ilokanščina",
+		Ind: "indonezijščina",
+		Ita: "italijanščina",
+		Jav: "javanščina",
+		Jpn: "japonščina",
+		Kan: "kanareščina",
+		Kat: "gruzijščina",
+		Khm: "kmerščina",
+		Kin: "ruandščina",
+		Kor: "korejščina",
+		Kur: "kurdščina",
+		Lav: "latvijščina",
+		Lit: "litovščina",
+		Mai: "maitili",
+		Mal: "malajalamščina",
+		Mar: "maratščina",
+		Mkd: "makedonščina",
+		Mlg: "malagaščina",
+		Mya: "burmanščina",
+		Nep: "nepalščina",
+		Nld: "nizozemščina",
+		Nno: "novonorveščina",
+		Nob: "knjižna norveščina",
+		Nya: "njanščina",
+		Ori: "odijščina",
+		Orm: "oromo",
+		Pan: "pandžabščina",
+		Pes: "perzijščina",
+		Pol: "poljščina",
+		Por: "portugalščina",
+		Ron: "romunščina",
+		Run: "rundščina",
+		Rus: "ruščina",
+		Sin: "sinhalščina",
+		Slv: "slovenščina",
+		Sna: "šonščina",
+		Som: "somalščina",
+		Spa: "španščina",
+		Srp: "srbščina",
+		Swe: "švedščina",
+		Tam: "tamilščina",
+		Tel: "telugijščina",
+		Tgl: "filipinščina",
+		Tha: "tajščina",
+		Tir: "tigrajščina",
+		Tuk: "turkmenščina",
+		Tur: "turščina",
+		Uig: "ujgurščina",
+		Ukr: "ukrajinščina",
+		Urd: "urdujščina",
+		Uzb: "uzbeščina",
+		Vie: "vietnamščina",
+		Ydd: "jidiš",
+		Yor: "jorubščina",
+		Zul: "zulujščina",
+	},
+	Sna: {
+		Aka: "chiAkani",
+		Amh: "chiAmaric",
+		Arb: "chiArabu",
+		Bel: "chiBelarusi",
+		Ben: "chiBengali",
+		Bul: "chiBulgarian",
+		Ces: "chiCzech",
+		Cmn: "chiChinese",
+		Deu: "chiJerimani",
+		Ell: "chiGreek",
+		Eng: "Chirungu",
+		Fra: "chiFurenchi",
+		Hau: "chiHausa",
+		Hin: "chiHindi",
+		Hun: "chiHungari",
+		Ibo: "chiIgbo",
+		Ind: "chiIndonesia",
+		Ita: "chiTariana",
+		Jav: "chiJava",
+		Jpn: "chiJapani",
+		Khm: "chiKhema",
+		Kin: "chiRwanda",
+		Kor: "chiKoria",
+		Mya: "chiBurma",
+		Nep: "chiNepali",
+		Nld: "chiDutch",
+		Pan: "chiPunjabi",
+		Pes: "chiPeshiya",
+		Pol: "chiPolish",
+		Por: "chiPutukezi",
+		Ron: "chiRomanian",
+		Rus: "chiRashiya",
+		Sna: "chiShona",
+		Som: "chiSomali",
+		Spa: "chiSpanish",
+		Swe: "chiSwedish",
+		Tam: "chiTamil",
+		Tha: "chiThai",
+		Tur: "chiTurkish",
+		Ukr: "chiUkrenia",
+		Urd: "chiUrdu",
+		Vie: "chiVietnam",
+		Yor: "chiYoruba",
+		Zul: "chiZulu",
+	},
+	Som: {
+		Aka: "Akan",
+		Amh: "Axmaari",
+		Arb: "Carabi",
+		Bel: "Beleruusiyaan",
+		Ben: "Bangaali",
+		Bul: "Bulgeeriyaan",
+		Ces: "Jeeg",
+		Cmn: "Jayniis",
+		Deu: "Jarmal",
+		Ell: "Giriik",
+		Eng: "Ingiriisi",
+		Fra: "Faransiis",
+		Hau: "Hawsa",
+		Hin: "Hindi",
+		Hun: "Hangariyaan",
+		Ibo: "Igbo",
+		Ind: "Indunuusiyaan",
+		Ita: "Talyaani",
+		Jav: "Jafaaniis",
+		Jpn: "Jabbaaniis",
+		Khm: "Kamboodhian",
+		Kin: "Rwanda",
+		Kor: "Kuuriyaan",
+		Mya: "Burmese",
+		Nep: "Nebaali",
+		Nld: "Holandays",
+		Pan: "Bunjaabi",
+		Pes: "Faarisi",
+		Pol: "Boolish",
+		Por: "Boortaqiis",
+		Ron: "Romanka",
+		Rus: "Ruush",
+		Som: "Soomaali",
+		Spa: "Isbaanish",
+		Swe: "Swiidhis",
+		Tam: "Tamiil",
+		Tha: "Taaylandays",
+		Tur: "Turkish",
+		Ukr: "Yukreeniyaan",
+		Urd: "Urduu",
+		Vie: "Fiitnaamays",
+		Yor: "Yoruuba",
+		Zul: "Zuulu",
+	},
+	Spa: {
+		Afr: "afrikáans",
+		Aka: "akan",
+		Amh: "amárico",
+		Arb: "árabe",
+		Azj: "azerbaiyano",
+		Bel: "bielorruso",
+		Ben: "bengalí",
+		Bho: "bhoyapurí",
+		Bul: "búlgaro",
+		Ceb: "cebuano",
+		Ces: "checo",
+		Cmn: "chino",
+		Dan: "danés",
+		Deu: "alemán",
+		Ell: "griego",
+		Eng: "inglés",
+		Epo: "esperanto",
+		Est: "estonio",
+		Fin: "finés",
+		Fra: "francés",
+		Guj: "guyaratí",
+		Hat: "criollo haitiano",
+		Hau: "hausa",
+		Heb: "hebreo",
+		Hin: "hindi",
+		Hrv: "croata",
+		Hun: "húngaro",
+		Ibo: "igbo",
+		Ilo: "ilocano",
+		Ind: "indonesio",
+		Ita: "italiano",
+		Jav: "javanés",
+		Jpn: "japonés",
+		Kan: "canarés",
+		Kat: "georgiano",
+		Khm: "jemer",
+		Kin: "kinyarwanda",
+		Kor: "coreano",
+		Kur: "kurdo",
+		Lav: "letón",
+		Lit: "lituano",
+		Mai: "maithili",
+		Mal: "malayalam",
+		Mar: "maratí",
+		Mkd: "macedonio",
+		Mlg: "malgache",
+		Mya: "birmano",
+		Nep: "nepalí",
+		Nld: "neerlandés",
+		Nno: "noruego nynorsk",
+		Nob: "noruego bokmal",
+		Nya: "nyanja",
+		Ori: "oriya",
+		Orm: "oromo",
+		Pan: "panyabí",
+		Pes: "persa",
+		Pol: "polaco",
+		Por: "portugués",
+		Ron: "rumano",
+		Run: "kirundi",
+		Rus: "ruso",
+		Sin: "cingalés",
+		Slv: "esloveno",
+		Sna: "shona",
+		Som: "somalí",
+		Spa: "español",
+		Srp: "serbio",
+		Swe: "sueco",
+		Tam: "tamil",
+		Tel: "telugu",
+		Tgl: "filipino",
+		Tha: "tailandés",
+		Tir: "tigriña",
+		Tuk: "turcomano",
+		Tur: "turco",
+		Uig: "uigur",
+		Ukr: "ucraniano",
+		Urd: "urdu",
+		Uzb: "uzbeko",
+		Vie: "vietnamita",
+		Ydd: "yidis",
+		Yor: "yoruba",
+		Zul: "zulú",
+	},
+	Srp: {
+		Afr: "африканс",
+		Aka: "акански",
+		Amh: "амхарски",
+		Arb: "арапски",
+		Azj: "азербејџански",
+		Bel: "белоруски",
+		Ben: "бенгалски",
+		Bho: "боџпури",
+		Bul: "бугарски",
+		Ceb: "себуански",
+		Ces: "чешки",
+		Cmn: "кинески",
+		Dan: "дански",
+		Deu: "немачки",
+		Ell: "грчки",
+		Eng: "енглески",
+		Epo: "есперанто",
+		Est: "естонски",
+		Fin: "фински",
+		Fra: "француски",
+		Guj: "гуџарати",
+		Hat: "хаићански",
+		Hau: "хауса",
+		Heb: "хебрејски",
+		Hin: "хинди",
+		Hrv: "хрватски",
+		Hun: "мађарски",
+		Ibo: "игбо",
+		Ilo: "илоко",
+		Ind: "индонежански",
+		Ita: "италијански",
+		Jav: "јавански",
+		Jpn: "јапански",
+		Kan: "канада",
+		Kat: "грузијски",
+		Khm: "кмерски",
+		Kin: "кињаруанда",
+		Kor: "корејски",
+		Kur: "курдски",
+		Lav: "летонски",
+		Lit: "литвански",
+		Mai: "маитили",
+		Mal: "малајалам",
+		Mar: "марати",
+		Mkd: "македонски",
+		Mlg: "малгашки",
+		Mya: "бурмански",
+		Nep: "непалски",
+		Nld: "холандски",
+		Nno: "норвешки нинорск",
+		Nob: "норвешки букмол",
+		Nya: "њанџа",
+		Ori: "одија",
+		Orm: "оромо",
+		Pan: "пенџапски",
+		Pes: "персијски",
+		Pol: "пољски",
+		Por: "португалски",
+		Ron: "румунски",
+		Run: "кирунди",
+		Rus: "руски",
+		Sin: "синхалешки",
+		Slv: "словеначки",
+		Sna: "шона",
+		Som: "сомалски",
+		Spa: "шпански",
+		Srp: "српски",
+		Swe: "шведски",
+		Tam: "тамилски",
+		Tel: "телугу",
+		Tgl: "филипински",
+		Tha: "тајски",
+		Tir: "тигриња",
+		Tuk: "туркменски",
+		Tur: "турски",
+		Uig: "ујгурски",
+		Ukr: "украјински",
+		Urd: "урду",
+		Uzb: "узбечки",
+		Vie: "вијетнамски",
+		Ydd: "јидиш",
+		Yor: "јоруба",
+		Zul: "зулу",
+	},
+	Swe: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amhariska",
+		Arb: "arabiska",
+		Azj: "azerbajdzjanska",
+		Bel: "vitryska",
+		Ben: "bengali",
+		Bho: "bhojpuri",
+		Bul: "bulgariska",
+		Ceb: "cebuano",
+		Ces: "tjeckiska",
+		Cmn: "kinesiska",
+		Dan: "danska",
+		Deu: "tyska",
+		Ell: "grekiska",
+		Eng: "engelska",
+		Epo: "esperanto",
+		Est: "estniska",
+		Fin: "finska",
+		Fra: "franska",
+		Guj: "gujarati",
+		Hat: "haitiska",
+		Hau: "hausa",
+		Heb: "hebreiska",
+		Hin: "hindi",
+		Hrv: "kroatiska",
+		Hun: "ungerska",
+		Ibo: "igbo",
+		Ilo: "iloko",
+		Ind: "indonesiska",
+		Ita: "italienska",
+		Jav: "javanesiska",
+		Jpn: "japanska",
+		Kan: "kannada",
+		Kat: "georgiska",
+		Khm: "kambodjanska",
+		Kin: "kinjarwanda",
+		Kor: "koreanska",
+		Kur: "kurdiska",
+		Lav: "lettiska",
+		Lit: "litauiska",
+		Mai: "maithili",
+		Mal: "malayalam",
+		Mar: "marathi",
+		Mkd: "makedonska",
+		Mlg: "malagassiska",
+		Mya: "burmesiska",
+		Nep: "nepalesiska",
+		Nld: "nederländska",
+		Nno: "nynorska",
+		Nob: "norskt bokmål",
+		Nya: "nyanja",
+		Ori: "oriya",
+		Orm: "oromo",
+		Pan: "punjabi",
+		Pes: "persiska",
+		Pol: "polska",
+		Por: "portugisiska",
+		Ron: "rumänska",
+		Run: "rundi",
+		Rus: "ryska",
+		Sin: "singalesiska",
+		Slv: "slovenska",
+		Sna: "shona",
+		Som: "somaliska",
+		Spa: "spanska",
+		Srp: "serbiska",
+		Swe: "svenska",
+		Tam: "tamil",
+		Tel: "telugu",
+		Tgl: "filippinska",
+		Tha: "thailändska",
+		Tir: "tigrinja",
+		Tuk: "turkmeniska",
+		Tur: "turkiska",
+		Uig: "uiguriska",
+		Ukr: "ukrainska",
+		Urd: "urdu",
+		Uzb: "uzbekiska",
+		Vie: "vietnamesiska",
+		Ydd: "jiddisch",
+		Yor: "yoruba",
+		Zul: "zulu",
+	},
+	Tam: {
+		Afr: "ஆஃப்ரிகான்ஸ்",
+		Aka: "அகான்",
+		Amh: "அம்ஹாரிக்",
+		Arb: "அரபிக்",
+		Azj: "அஸர்பைஜானி",
+		Bel: "பெலாருஷியன்",
+		Ben: "வங்காளம்",
+		Bho: "போஜ்பூரி",
+		Bul: "பல்கேரியன்",
+		Ceb: "செபுவானோ",
+		Ces: "செக்",
+		Cmn: "சீனம்",
+		Dan: "டேனிஷ்",
+		Deu: "ஜெர்மன்",
+		Ell: "கிரேக்கம்",
+		Eng: "ஆங்கிலம்",
+		Epo: "எஸ்பரேன்டோ",
+		Est: "எஸ்டோனியன்",
+		Fin: "ஃபின்னிஷ்",
+		Fra: "பிரெஞ்சு",
+		Guj: "குஜராத்தி",
+		Hat: "ஹைத்தியன் க்ரியோலி",
+		Hau: "ஹௌஸா",
+		Heb: "ஹீப்ரூ",
+		Hin: "இந்தி",
+		Hrv: "குரோஷியன்",
+		Hun: "ஹங்கேரியன்",
+		Ibo: "இக்போ",
+		Ilo: "இலோகோ",
+		Ind: "இந்தோனேஷியன்",
+		Ita: "இத்தாலியன்",
+		Jav: "ஜாவனீஸ்",
+		Jpn: "ஜப்பானியம்",
+		Kan: "கன்னடம்",
+		Kat: "ஜார்ஜியன்",
+		Khm: "கெமெர்",
+		Kin: "கின்யாருவான்டா",
+		Kor: "கொரியன்",
+		Kur: "குர்திஷ்",
+		Lav: "லாட்வியன்",
+		Lit: "லிதுவேனியன்",
+		Mai: "மைதிலி",
+		Mal: "மலையாளம்",
+		Mar: "மராத்தி",
+		Mkd: "மாஸிடோனியன்",
+		Mlg: "மலகாஸி",
+		Mya: "பர்மீஸ்",
+		Nep: "நேபாளி",
+		Nld: "டச்சு",
+		Nno: "நார்வேஜியன் நியூநார்ஸ்க்",
+		Nob: "நார்வேஜியன் பொக்மால்",
+		Nya: "நயன்ஜா",
+		Ori: "ஒடியா",
+		Orm: "ஒரோமோ",
+		Pan: "பஞ்சாபி",
+		Pes: "பெர்ஷியன்",
+		Pol: "போலிஷ்",
+		Por: "போர்ச்சுக்கீஸ்",
+		Ron: "ரோமேனியன்",
+		Run: "ருண்டி",
+		Rus: "ரஷியன்",
+		Sin: "சிங்களம்",
+		Slv: "ஸ்லோவேனியன்",
+		Sna: "ஷோனா",
+		Som: "சோமாலி",
+		Spa: "ஸ்பானிஷ்",
+		Srp: "செர்பியன்",
+		Swe: "ஸ்வீடிஷ்",
+		Tam: "தமிழ்",
+		Tel: "தெலுங்கு",
+		Tgl: "ஃபிலிபினோ",
+		Tha: "தாய்",
+		Tir: "டிக்ரின்யா",
+		Tuk: "துருக்மென்",
+		Tur: "துருக்கிஷ்",
+		Uig: "உய்குர்",
+		Ukr: "உக்ரைனியன்",
+		Urd: "உருது",
+		Uzb: "உஸ்பெக்",
+		Vie: "வியட்நாமீஸ்",
+		Ydd: "யெட்டிஷ்",
+		Yor: "யோருபா",
+		Zul: "ஜுலு",
+	},
+	Tel: {
+		Afr: "ఆఫ్రికాన్స్",
+		Aka: "అకాన్",
+		Amh: "అమ్హారిక్",
+		Arb: "అరబిక్",
+		Azj: "అజర్బైజాని",
+		Bel: "బెలరుషియన్",
+		Ben: "బాంగ్లా",
+		Bho: "భోజ్\u200cపురి",
+		Bul: "బల్గేరియన్",
+		Ceb: "సెబుయానో",
+		Ces: "చెక్",
+		Cmn: "చైనీస్",
+		Dan: "డానిష్",
+		Deu: "జర్మన్",
+		Ell: "గ్రీక్",
+		Eng: "ఆంగ్లం",
+		Epo: "ఎస్పెరాంటో",
+		Est: "ఎస్టోనియన్",
+		Fin: "ఫిన్నిష్",
+		Fra: "ఫ్రెంచ్",
+		Guj: "గుజరాతి",
+		Hat: "హైటియన్ క్రియోల్",
+		Hau: "హౌసా",
+		Heb: "హీబ్రూ",
+		Hin: "హిందీ",
+		Hrv: "క్రోయేషియన్",
+		Hun: "హంగేరియన్",
+		Ibo: "ఇగ్బో",
+		Ilo: "ఐలోకో",
+		Ind: "ఇండోనేషియన్",
+		Ita: "ఇటాలియన్",
+		Jav: "జావనీస్",
+		Jpn: "జపనీస్",
+		Kan: "కన్నడ",
+		Kat: "జార్జియన్",
+		Khm: "ఖ్మేర్",
+		Kin: "కిన్యర్వాండా",
+		Kor: "కొరియన్",
+		Kur: "కుర్దిష్",
+		Lav: "లాట్వియన్",
+		Lit: "లిథువేనియన్",
+		Mai: "మైథిలి",
+		Mal: "మలయాళం",
+		Mar: "మరాఠీ",
+		Mkd: "మసడోనియన్",
+		Mlg: "మాలాగసి",
+		Mya: "బర్మీస్",
+		Nep: "నేపాలి",
+		Nld: "డచ్",
+		Nno: "నార్వేజియాన్ న్యోర్స్క్",
+		Nob: "నార్వేజియన్ బొక్మాల్",
+		Nya: "న్యాన్జా",
+		Ori: "ఒడియా",
+		Orm: "ఒరోమో",
+		Pan: "పంజాబీ",
+		Pes: "పర్షియన్",
+		Pol: "పోలిష్",
+		Por: "పోర్చుగీస్",
+		Ron: "రోమానియన్",
+		Run: "రుండి",
+		Rus: "రష్యన్",
+		Sin: "సింహళం",
+		Slv: "స్లోవేనియన్",
+		Sna: "షోన",
+		Som: "సోమాలి",
+		Spa: "స్పానిష్",
+		Srp: "సెర్బియన్",
+		Swe: "స్వీడిష్",
+		Tam: "తమిళము",
+		Tel: "తెలుగు",
+		Tgl: "ఫిలిపినో",
+		Tha: "థాయ్",
+		Tir: "తిగ్రిన్యా",
+		Tuk: "తుర్క్\u200cమెన్",
+		Tur: "టర్కిష్",
+		Uig: "ఉయ్\u200cఘర్",
+		Ukr: "ఉక్రేనియన్",
+		Urd: "ఉర్దూ",
+		Uzb: "ఉజ్బెక్",
+		Vie: "వియత్నామీస్",
+		Ydd: "ఇడ్డిష్",
+		Yor: "యోరుబా",
+		Zul: "జూలూ",
+	},
+	Tgl: {
+		Afr: "Afrikaans",
+		Aka: "Akan",
+		Amh: "Amharic",
+		Arb: "Arabic",
+		Azj: "Azerbaijani",
+		Bel: "Belarusian",
+		Ben: "Bangla",
+		Bho: "Bhojpuri",
+		Bul: "Bulgarian",
+		Ceb: "Cebuano",
+		Ces: "Czech",
+		Cmn: "Chinese",
+		Dan: "Danish",
+		Deu: "German",
+		Ell: "Greek",
+		Eng: "Ingles",
+		Epo: "Esperanto",
+		Est: "Estonian",
+		Fin: "Finnish",
+		Fra: "French",
+		Guj: "Gujarati",
+		Hat: "Haitian",
+		Hau: "Hausa",
+		Heb: "Hebrew",
+		Hin: "Hindi",
+		Hrv: "Croatian",
+		Hun: "Hungarian",
+		Ibo: "Igbo",
+		Ilo: "Iloko",
+		Ind: "Indonesian",
+		Ita: "Italian",
+		Jav: "Javanese",
+		Jpn: "Japanese",
+		Kan: "Kannada",
+		Kat: "Georgian",
+		Khm: "Khmer",
+		Kin: "Kinyarwanda",
+		Kor: "Korean",
+		Kur: "Kurdish",
+		Lav: "Latvian",
+		Lit: "Lithuanian",
+		Mai: "Maithili",
+		Mal: "Malayalam",
+		Mar: "Marathi",
+		Mkd: "Macedonian",
+		Mlg: "Malagasy",
+		Mya: "Burmese",
+		Nep: "Nepali",
+		Nld: "Dutch",
+		Nno: "Norwegian Nynorsk",
+		Nob: "Norwegian Bokmål",
+		Nya: "Nyanja",
+		Ori: "Odia",
+		Orm: "Oromo",
+		Pan: "Punjabi",
+		Pes: "Persian",
+		Pol: "Polish",
+		Por: "Portuguese",
+		Ron: "Romanian",
+		Run: "Rundi",
+		Rus: "Russian",
+		Sin: "Sinhala",
+		Slv: "Slovenian",
+		Sna: "Shona",
+		Som: "Somali",
+		Spa: "Spanish",
+		Srp: "Serbian",
+		Swe: "Swedish",
+		Tam: "Tamil",
+		Tel: "Telugu",
+		Tgl: "Filipino",
+		Tha: "Thai",
+		Tir: "Tigrinya",
+		Tuk: "Turkmen",
+		Tur: "Turkish",
+		Uig: "Uyghur",
+		Ukr: "Ukranian",
+		Urd: "Urdu",
+		Uzb: "Uzbek",
+		Vie: "Vietnamese",
+		Ydd: "Yiddish",
+		Yor: "Yoruba",
+		Zul: "Zulu",
+	},
+	Tha: {
+		Afr: "แอฟริกานส์",
+		Aka: "อาคาน",
+		Amh: "อัมฮารา",
+		Arb: "อาหรับ",
+		Azj: "อาเซอร์ไบจาน",
+		Bel: "เบลารุส",
+		Ben: "เบงกาลี",
+		Bho: "โภชปุรี",
+		Bul: "บัลแกเรีย",
+		Ceb: "เซบู",
+		Ces: "เช็ก",
+		Cmn: "จีน",
+		Dan: "เดนมาร์ก",
+		Deu: "เยอรมัน",
+		Ell: "กรีก",
+		Eng: "อังกฤษ",
+		Epo: "เอสเปรันโต",
+		Est: "เอสโตเนีย",
+		Fin: "ฟินแลนด์",
+		Fra: "ฝรั่งเศส",
+		Guj: "คุชราต",
+		Hat: "เฮติครีโอล",
+		Hau: "เฮาซา",
+		Heb: "ฮิบรู",
+		Hin: "ฮินดี",
+		Hrv: "โครเอเชีย",
+		Hun: "ฮังการี",
+		Ibo: "อิกโบ",
+		Ilo: "อีโลโก",
+		Ind: "อินโดนีเซีย",
+		Ita: "อิตาลี",
+		Jav: "ชวา",
+		Jpn: "ญี่ปุ่น",
+		Kan: "กันนาดา",
+		Kat: "จอร์เจีย",
+		Khm: "เขมร",
+		Kin: "รวันดา",
+		Kor: "เกาหลี",
+		Kur: "เคิร์ด",
+		Lav: "ลัตเวีย",
+		Lit: "ลิทัวเนีย",
+		Mai: "ไมถิลี",
+		Mal: "มาลายาลัม",
+		Mar: "มราฐี",
+		Mkd: "มาซิโดเนีย",
+		Mlg: "มาลากาซี",
+		Mya: "พม่า",
+		Nep: "เนปาล",
+		Nld: "ดัตช์",
+		Nno: "นอร์เวย์นีนอสก์",
+		Nob: "นอร์เวย์บุคมอล",
+		Nya: "เนียนจา",
+		Ori: "โอริยา",
+		Orm: "โอโรโม",
+		Pan: "ปัญจาบ",
+		Pes: "เปอร์เซีย",
+		Pol: "โปแลนด์",
+		Por: "โปรตุเกส",
+		Ron: "โรมาเนีย",
+		Run: "บุรุนดี",
+		Rus: "รัสเซีย",
+		Sin: "สิงหล",
+		Slv: "สโลวีเนีย",
+		Sna: "โชนา",
+		Som: "โซมาลี",
+		Spa: "สเปน",
+		Srp: "เซอร์เบีย",
+		Swe: "สวีเดน",
+		Tam: "ทมิฬ",
+		Tel: "เตลูกู",
+		Tgl: "ฟิลิปปินส์",
+		Tha: "ไทย",
+		Tir: "ติกริญญา",
+		Tuk: "เติร์กเมน",
+		Tur: "ตุรกี",
+		Uig: "อุยกูร์",
+		Ukr: "ยูเครน",
+		Urd: "อูรดู",
+		Uzb: "อุซเบก",
+		Vie: "เวียดนาม",
+		Ydd: "ยิดดิช",
+		Yor: "โยรูบา",
+		Zul: "ซูลู",
+	},
+	Tir: {
+		Afr: "አፍሪቃንሰኛ",
+		Aka: "ትዊ",
+		Amh: "አምሐረኛ",
+		Arb: "ዓረበኛ",
+		Azj: "አዜርባይጃንኛ",
+		Bel: "ቤላራሻኛ",
+		Ben: "በንጋሊኛ",
+		Bul: "ቡልጋሪኛ",
+		Ces: "ቼክኛ",
+		Dan: "ዴኒሽ",
+		Deu: "ጀርመን",
+		Ell: "ግሪከኛ",
+		Eng: "እንግሊዝኛ",
+		Epo: "ኤስፐራንቶ",
+		Est: "ኤስቶኒአን",
+		Fin: "ፊኒሽ",
+		Fra: "ፈረንሳይኛ",
+		Guj: "ጉጃራቲኛ",
+		Heb: "ዕብራስጥ",
+		Hin: "ሕንደኛ",
+		Hrv: "ክሮሽያንኛ",
+		Hun: "ሀንጋሪኛ",
+		Ind: "እንዶኑሲኛ",
+		Ita: "ጣሊያንኛ",
+		Jav: "ጃቫንኛ",
+		Jpn: "ጃፓንኛ",
+		Kan: "ካማደኛ",
+		Kat: "ጊዮርጊያኛ",
+		Kor: "ኮሪያኛ",
+		Kur: "ኩርድሽ",
+		Lav: "ላቲቪያን",
+		Lit: "ሊቱአኒየን",
+		Mal: "ማላያላምኛ",
+		Mar: "ማራቲኛ",
+		Mkd: "ማክዶኒኛ",
+		Nep: "ኔፖሊኛ",
+		Nld: "ደች",
+		Nno: "ኖርዌይኛ (ናይ ኝኖርስክ)",
+		Nob: "ኖርዌጂያን",
+		Ori: "ኦሪያ",
+		Pan: "ፑንጃቢኛ",
+		Pes: "ፐርሲያኛ",
+		Pol: "ፖሊሽ",
+		Por: "ፖርቱጋሊኛ",
+		Ron: "ሮማኒያን",
+		Rus: "ራሽኛ",
+		Sin: "ስንሃልኛ",
+		Slv: "ስቁቪኛ",
+		Spa: "ስፓኒሽ",
+		Srp: "ሰርቢኛ",
+		Swe: "ስዊድንኛ",
+		Tam: "ታሚልኛ",
+		Tel: "ተሉጉኛ",
+		Tgl: "ታጋሎገኛ",
+		Tha: "ታይኛ",
+		Tir: "ትግርኛ",
+		Tuk: "ናይ ቱርኪ ሰብዓይ (ቱርካዊ)",
+		Tur: "ቱርከኛ",
+		Ukr: "ዩክረኒኛ",
+		Urd: "ኡርዱኛ",
+		Uzb: "ኡዝበክኛ",
+		Vie: "ቪትናምኛ",
+		Ydd: "ዪዲሽ",
+		Zul: "ዙሉኛ",
+	},
+	Tuk: {
+		Afr: "Afrikaans dili",
+		Aka: "Akan dili",
+		Amh: "Amhar dili",
+		Arb: "Arap dili",
+		Azj: "Azerbaýjan dili",
+		Bel: "Belarus dili",
+		Ben: "Bengal dili",
+		Bho: "Bhojpuri dili",
+		Bul: "Bolgar dili",
+		Ceb: "Sebuan dili",
+		Ces: "Çeh dili",
+		Cmn: "Hytaý dili",
+		Dan: "Daniýa dili",
+		Deu: "Nemes dili",
+		Ell: "Grek dili",
+		Eng: "Iňlis dili",
+		Epo: "Esperanto dili",
+		Est: "Eston dili",
+		Fin: "Fin dili",
+		Fra: "Fransuz dili",
+		Guj: "Gujarati dili",
+		Hat: "Gaiti kreol dili",
+		Hau: "Hausa dili",
+		Heb: "Ýewreý dili",
+		Hin: "Hindi dili",
+		Hrv: "Horwat dili",
+		Hun: "Wenger dili",
+		Ibo: "Igbo dili",
+		Ilo: "Iloko dili",
+		Ind: "Indonez dili",
+		Ita: "Italýan dili",
+		Jav: "Ýawa dili",
+		Jpn: "Ýapon dili",
+		Kan: "Kannada dili",
+		Kat: "Gruzin dili",
+		Khm: "Khmer dili",
+		Kin: "Kinýaruanda dili",
+		Kor: "Koreý dili",
+		Kur: "Kürt dili",
+		Lav: "Latyş dili",
+		Lit: "Litwa dili",
+		Mai: "Maýthili dili",
+		Mal: "Malaýalam dili",
+		Mar: "Marathi dili",
+		Mkd: "Makedon dili",
+		Mlg: "Malagasiý dili",
+		Mya: "Birma dili",
+		Nep: "Nepal dili",
+		Nld: "Niderland dili",
+		Nno: "Norwegiýa nýunorsk dili",
+		Nob: "Norwegiýa bukmol dili",
+		Nya: "Nýanja dili",
+		Ori: "Oriýa dili",
+		Orm: "Oromo dili",
+		Pan: "Penjab dili",
+		Pes: "Pars dili",
+		Pol: "Polýak dili",
+		Por: "Portugal dili",
+		Ron: "Rumyn dili",
+		Run: "Rundi dili",
+		Rus: "Rus dili",
+		Sin: "Singal dili",
+		Slv: "Slowen dili",
+		Sna: "Şona dili",
+		Som: "Somali dili",
+		Spa: "Ispan dili",
+		Srp: "Serb dili",
+		Swe: "Şwed dili",
+		Tam: "Tamil dili",
+		Tel: "Telugu dili",
+		Tgl: "Filippin dili",
+		Tha: "Taý dili",
+		Tir: "Tigrinýa dili",
+		Tuk: "Türkmen dili",
+		Tur: "Türk dili",
+		Uig: "Uýgur dili",
+		Ukr: "Ukrain dili",
+		Urd: "Urdu",
+		Uzb: "Özbek dili",
+		Vie: "Wýetnam dili",
+		Ydd: "Idiş dili",
+		Yor: "Ýoruba dili",
+		Zul: "Zulu dili",
+	},
+	Tur: {
+		Afr: "Afrikaanca",
+		Aka: "Akan",
+		Amh: "Amharca",
+		Arb: "Arapça",
+		Azj: "Azerice",
+		Bel: "Belarusça",
+		Ben: "Bengalce",
+		Bho: "Arayanice",
+		Bul: "Bulgarca",
+		Ceb: "Sebuano dili",
+		Ces: "Çekçe",
+		Cmn: "Çince",
+		Dan: "Danca",
+		Deu: "Almanca",
+		Ell: "Yunanca",
+		Eng: "İngilizce",
+		Epo: "Esperanto",
+		Est: "Estonca",
+		Fin: "Fince",
+		Fra: "Fransızca",
+		Guj: "Güceratça",
+		Hat: "Haiti Kreyolu",
+		Hau: "Hausa dili",
+		Heb: "İbranice",
+		Hin: "Hintçe",
+		Hrv: "Hırvatça",
+		Hun: "Macarca",
+		Ibo: "İbo dili",
+		Ilo: "Iloko",
+		Ind: "Endonezce",
+		Ita: "İtalyanca",
+		Jav: "Cava Dili",
+		Jpn: "Japonca",
+		Kan: "Kannada dili",
+		Kat: "Gürcüce",
+		Khm: "Khmer dili",
+		Kin: "Kinyarwanda",
+		Kor: "Korece",
+		Kur: "Kürtçe",
+		Lav: "Letonca",
+		Lit: "Litvanca",
+		Mai: "Maithili",
+		Mal: "Malayalam dili",
+		Mar: "Marathi dili",
+		Mkd: "Makedonca",
+		Mlg: "Malgaşça",
+		Mya: "Birman dili",
+		Nep: "Nepalce",
+		Nld: "Felemenkçe",
+		Nno: "Norveççe Nynorsk",
+		Nob: "Norveççe Bokmål",
+		Nya: "Nyanja",
+		Ori: "Oriya Dili",
+		Orm: "Oromo dili",
+		Pan: "Pencapça",
+		Pes: "Farsça",
+		Pol: "Lehçe",
+		Por: "Portekizce",
+		Ron: "Rumence",
+		Run: "Kirundi",
+		Rus: "Rusça",
+		Sin: "Sinhali dili",
+		Slv: "Slovence",
+		Sna: "Shona",
+		Som: "Somalice",
+		Spa: "İspanyolca",
+		Srp: "Sırpça",
+		Swe: "İsveççe",
+		Tam: "Tamilce",
+		Tel: "Telugu dili",
+		Tgl: "Filipince",
+		Tha: "Tayca",
+		Tir: "Tigrinya dili",
+		Tuk: "Türkmence",
+		Tur: "Türkçe",
+		Uig: "Uygurca",
+		Ukr: "Ukraynaca",
+		Urd: "Urduca",
+		Uzb: "Özbekçe",
+		Vie: "Vietnamca",
+		Ydd: "Yidiş",
+		Yor: "Yorubaca",
+		Zul: "Zuluca",
+	},
+	Uig: {
+		Afr: "ئافرىكانچە",
+		Aka: "ئاكانچە",
+		Amh: "ئامھارچە",
+		Arb: "ئەرەبچە",
+		Azj: "ئەزەربەيجانچە",
+		Bel: "بېلارۇسچە",
+		Ben: "بېنگالچە",
+		Bho: "بوجپۇرىچە",
+		Bul: "بۇلغارچە",
+		Ceb: "سېبۇچە",
+		Ces: "چېخچە",
+		Cmn: "خەنزۇچە",
+		Dan: "دانىشچە",
+		Deu: "گېرمانچە",
+		Ell: "گىرېكچە",
+		Eng: "ئىنگلىزچە",
+		Epo: "ئېسپرانتوچە",
+		Est: "ئېستونچە",
+		Fin: "فىنچە",
+		Fra: "فىرانسۇزچە",
+		Guj: "گۇجاراتچە",
+		Hat: "ھايتىچە",
+		Hau: "خائۇساچە",
+		Heb: "ئىبرانىيچە",
+		Hin: "ھىندىچە",
+		Hrv: "كىرودىچە",
+		Hun: "ۋېنگىرچە",
+		Ibo: "ئىگبوچە",
+		Ilo: "ئىلوكانوچە",
+		Ind: "ھىندونېزچە",
+		Ita: "ئىتالىيانچە",
+		Jav: "ياۋاچە",
+		Jpn: "ياپونچە",
+		Kan: "كانناداچە",
+		Kat: "گىرۇزچە",
+		Khm: "كىمېرچە",
+		Kin: "كېنىيەرىۋانداچە",
+		Kor: "كورېيەچە",
+		Kur: "كۇردچە",
+		Lav: "لاتچە",
+		Lit: "لىتۋانىچە",
+		Mai: "مايتىلىچە",
+		Mal: "مالايالامچە",
+		Mar: "ماراتىچە",
+		Mkd: "ماكېدونچە",
+		Mlg: "مالاگاسچە",
+		Mya: "بىرماچە",
+		Nep: "نېپالچە",
+		Nld: "گوللاندچە",
+		Nno: "يېڭى نورۋېگچە",
+		Nob: "نورۋىگىيە بوكمالچە",
+		Nya: "نىيانجاچە",
+		Ori: "ئودىياچە",
+		Orm: "ئوروموچە",
+		Pan: "پەنجابچە",
+		Pes: "پارسچە",
+		Pol: "پولەكچە",
+		Por: "پورتۇگالچە",
+		Ron: "رومىنچە",
+		Run: "رۇندىچە",
+		Rus: "رۇسچە",
+		Sin: "سىنگالچە",
+		Slv: "سىلوۋېنچە",
+		Sna: "شوناچە",
+		Som: "سومالىچە",
+		Spa: "ئىسپانچە",
+		Srp: "سېربچە",
+		Swe: "شىۋېدچە",
+		Tam: "تامىلچە",
+		Tel: "تېلۇگۇچە",
+		Tgl: "فىلىپپىنچە",
+		Tha: "تايلاندچە",
+		Tir: "تىگرىنياچە",
+		Tuk: "تۈركمەنچە",
+		Tur: "تۈركچە",
+		Uig: "ئۇيغۇرچە",
+		Ukr: "ئۇكرائىنچە",
+		Urd: "ئوردۇچە",
+		Uzb: "ئۆزبېكچە",
+		Vie: "ۋىيېتنامچە",
+		Ydd: "يىددىشچە",
+		Yor: "يورۇباچە",
+		Zul: "زۇلۇچە",
+	},
+	Ukr: {
+		Afr: "африкаанс",
+		Aka: "акан",
+		Amh: "амхарська",
+		Arb: "арабська",
+		Azj: "азербайджанська",
+		Bel: "білоруська",
+		Ben: "банґла",
+		Bho: "бходжпурі",
+		Bul: "болгарська",
+		Ceb: "себуанська",
+		Ces: "чеська",
+		Cmn: "китайська",
+		Dan: "данська",
+		Deu: "німецька",
+		Ell: "грецька",
+		Eng: "англійська",
+		Epo: "есперанто",
+		Est: "естонська",
+		Fin: "фінська",
+		Fra: "французька",
+		Guj: "гуджараті",
+		Hat: "гаїтянська",
+		Hau: "хауса",
+		Heb: "іврит",
+		Hin: "гінді",
+		Hrv: "хорватська",
+		Hun: "угорська",
+		Ibo: "ігбо",
+		Ilo: "ілоканська",
+		Ind: "індонезійська",
+		Ita: "італійська",
+		Jav: "яванська",
+		Jpn: "японська",
+		Kan: "каннада",
+		Kat: "грузинська",
+		Khm: "кхмерська",
+		Kin: "кіньяруанда",
+		Kor: "корейська",
+		Kur: "курдська",
+		Lav: "латвійська",
+		Lit: "литовська",
+		Mai: "майтхілі",
+		Mal: "малаялам",
+		Mar: "маратхі",
+		Mkd: "македонська",
+		Mlg: "малагасійська",
+		Mya: "бірманська",
+		Nep: "непальська",
+		Nld: "нідерландська",
+		Nno: "норвезька (нюношк)",
+		Nob: "норвезька (букмол)",
+		Nya: "ньянджа",
+		Ori: "одія",
+		Orm: "оромо",
+		Pan: "панджабі",
+		Pes: "перська",
+		Pol: "польська",
+		Por: "портуґальська",
+		Ron: "румунська",
+		Run: "рунді",
+		Rus: "російська",
+		Sin: "сингальська",
+		Slv: "словенська",
+		Sna: "шона",
+		Som: "сомалі",
+		Spa: "іспанська",
+		Srp: "сербська",
+		Swe: "шведська",
+		Tam: "тамільська",
+		Tel: "телугу",
+		Tgl: "філіппінська",
+		Tha: "тайська",
+		Tir: "тигринья",
+		Tuk: "туркменська",
+		Tur: "турецька",
+		Uig: "уйгурська",
+		Ukr: "українська",
+		Urd: "урду",
+		Uzb: "узбецька",
+		Vie: "вʼєтнамська",
+		Ydd: "їдиш",
+		Yor: "йоруба",
+		Zul: "зулуська",
+	},
+	Urd: {
+		Afr: "افریقی",
+		Aka: "اکان",
+		Amh: "امہاری",
+		Arb: "عربی",
+		Azj: "آذربائیجانی",
+		Bel: "بیلاروسی",
+		Ben: "بنگالی",
+		Bho: "بھوجپوری",
+		Bul: "بلغاری",
+		Ceb: "سیبوآنو",
+		Ces: "چیک",
+		Cmn: "چینی",
+		Dan: "ڈینش",
+		Deu: "جرمن",
+		Ell: "یونانی",
+		Eng: "انگریزی",
+		Epo: "ایسپرانٹو",
+		Est: "اسٹونین",
+		Fin: "فینیش",
+		Fra: "فرانسیسی",
+		Guj: "گجراتی",
+		Hat: "ہیتی",
+		Hau: "ہؤسا",
+		Heb: "عبرانی",
+		Hin: "ہندی",
+		Hrv: "کراتی",
+		Hun: "ہنگیرین",
+		Ibo: "اِگبو",
+		Ilo: "ایلوکو",
+		Ind: "انڈونیثیائی",
+		Ita: "اطالوی",
+		Jav: "جاوی",
+		Jpn: "جاپانی",
+		Kan: "کنّاڈا",
+		Kat: "جارجیائی",
+		Khm: "خمیر",
+		Kin: "کینیاروانڈا",
+		Kor: "کوریائی",
+		Kur: "کردش",
+		Lav: "لیٹوین",
+		Lit: "لیتھوینین",
+		Mai: "میتھیلی",
+		Mal: "مالایالم",
+		Mar: "مراٹهی",
+		Mkd: "مقدونیائی",
+		Mlg: "ملاگاسی",
+		Mya: "برمی",
+		Nep: "نیپالی",
+		Nld: "ڈچ",
+		Nno: "نارویجین نینورسک",
+		Nob: "نارویجین بوکمل",
+		Nya: "نیانجا",
+		Ori: "اڑیہ",
+		Orm: "اورومو",
+		Pan: "پنجابی",
+		Pes: "فارسی",
+		Pol: "پولش",
+		Por: "پُرتگالی",
+		Ron: "رومینین",
+		Run: "رونڈی",
+		Rus: "روسی",
+		Sin: "سنہالا",
+		Slv: "سلووینیائی",
+		Sna: "شونا",
+		Som: "صومالی",
+		Spa: "ہسپانوی",
+		Srp: "سربین",
+		Swe: "سویڈش",
+		Tam: "تمل",
+		Tel: "تیلگو",
+		Tgl: "فلیپینو",
+		Tha: "تھائی",
+		Tir: "ٹگرینیا",
+		Tuk: "ترکمان",
+		Tur: "ترکی",
+		Uig: "یوئگہر",
+		Ukr: "یوکرینیائی",
+		Urd: "اردو",
+		Uzb: "ازبیک",
+		Vie: "ویتنامی",
+		Ydd: "یدش",
+		Yor: "یوروبا",
+		Zul: "زولو",
+	},
+	Uzb: {
+		Afr: "afrikaans",
+		Aka: "akan",
+		Amh: "amxar",
+		Arb: "arab",
+		Azj: "ozarbayjon",
+		Bel: "belarus",
+		Ben: "bengal",
+		Bho: "bxojpuri",
+		Bul: "bolgar",
+		Ceb: "sebuan",
+		Ces: "chex",
+		Cmn: "xitoy",
+		Dan: "dan",
+		Deu: "nemischa",
+		Ell: "grek",
+		Eng: "inglizcha",
+		Epo: "esperanto",
+		Est: "estoncha",
+		Fin: "fincha",
+		Fra: "fransuzcha",
+		Guj: "gujarot",
+		Hat: "gaityan",
+		Hau: "xausa",
+		Heb: "ivrit",
+		Hin: "hind",
+		Hrv: "xorvat",
+		Hun: "venger",
+		Ibo: "igbo",
+		Ilo: "iloko",
+		Ind: "indonez",
+		Ita: "italyan",
+		Jav: "yavan",
+		Jpn: "yapon",
+		Kan: "kannada",
+		Kat: "gruzincha",
+		Khm: "xmer",
+		Kin: "kinyaruanda",
+		Kor: "koreyscha",
+		Kur: "kurdcha",
+		Lav: "latishcha",
+		Lit: "litva",
+		Mai: "maythili",
+		Mal: "malayalam",
+		Mar: "maratxi",
+		Mkd: "makedon",
+		Mlg: "malagasiy",
+		Mya: "birman",
+		Nep: "nepal",
+		Nld: "golland",
+		Nno: "norveg-nyunorsk",
+		Nob: "norveg-bokmal",
+		Nya: "cheva",
+		Ori: "oriya",
+		Orm: "oromo",
+		Pan: "panjobcha",
+		Pes: "fors",
+		Pol: "polyakcha",
+		Por: "portugalcha",
+		Ron: "rumincha",
+		Run: "rundi",
+		Rus: "ruscha",
+		Sin: "singal",
+		Slv: "slovencha",
+		Sna: "shona",
+		Som: "somalicha",
+		Spa: "ispancha",
+		Srp: "serbcha",
+		Swe: "shved",
+		Tam: "tamil",
+		Tel: "telugu",
+		Tgl: "filipincha",
+		Tha: "tay",
+		Tir: "tigrinya",
+		Tuk: "turkman",
+		Tur: "turk",
+		Uig: "uyg‘ur",
+		Ukr: "ukrain",
+		Urd: "urdu",
+		Uzb: "o‘zbek",
+		Vie: "vyetnam",
+		Ydd: "idish",
+		Yor: "yoruba",
+		Zul: "zulu",
+	},
+	Vie: {
+		Afr: "Tiếng Afrikaans",
+		Aka: "Tiếng Akan",
+		Amh: "Tiếng Amharic",
+		Arb: "Tiếng Ả Rập",
+		Azj: "Tiếng Azerbaijan",
+		Bel: "Tiếng Belarus",
+		Ben: "Tiếng Bangla",
+		Bho: "Tiếng Bhojpuri",
+		Bul: "Tiếng Bulgaria",
+		Ceb: "Tiếng Cebuano",
+		Ces: "Tiếng Séc",
+		Cmn: "Tiếng Trung",
+		Dan: "Tiếng Đan Mạch",
+		Deu: "Tiếng Đức",
+		Ell: "Tiếng Hy Lạp",
+		Eng: "Tiếng Anh",
+		Epo: "Tiếng Quốc Tế Ngữ",
+		Est: "Tiếng Estonia",
+		Fin: "Tiếng Phần Lan",
+		Fra: "Tiếng Pháp",
+		Guj: "Tiếng Gujarati",
+		Hat: "Tiếng Haiti",
+		Hau: "Tiếng Hausa",
+		Heb: "Tiếng Do Thái",
+		Hin: "Tiếng Hindi",
+		Hrv: "Tiếng Croatia",
+		Hun: "Tiếng Hungary",
+		Ibo: "Tiếng Igbo",
+		Ilo: "Tiếng Iloko",
+		Ind: "Tiếng Indonesia",
+		Ita: "Tiếng Italy",
+		Jav: "Tiếng Java",
+		Jpn: "Tiếng Nhật",
+		Kan: "Tiếng Kannada",
+		Kat: "Tiếng Georgia",
+		Khm: "Tiếng Khmer",
+		Kin: "Tiếng Kinyarwanda",
+		Kor: "Tiếng Hàn",
+		Kur: "Tiếng Kurd",
+		Lav: "Tiếng Latvia",
+		Lit: "Tiếng Litva",
+		Mai: "Tiếng Maithili",
+		Mal: "Tiếng Malayalam",
+		Mar: "Tiếng Marathi",
+		Mkd: "Tiếng Macedonia",
+		Mlg: "Tiếng Malagasy",
+		Mya: "Tiếng Miến Điện",
+		Nep: "Tiếng Nepal",
+		Nld: "Tiếng Hà Lan",
+		Nno: "Tiếng Na Uy (Nynorsk)",
+		Nob: "Tiếng Na Uy (Bokmål)",
+		Nya: "Tiếng Nyanja",
+		Ori: "Tiếng Odia",
+		Orm: "Tiếng Oromo",
+		Pan: "Tiếng Punjab",
+		Pes: "Tiếng Ba Tư",
+		Pol: "Tiếng Ba Lan",
+		Por: "Tiếng Bồ Đào Nha",
+		Ron: "Tiếng Romania",
+		Run: "Tiếng Rundi",
+		Rus: "Tiếng Nga",
+		Sin: "Tiếng Sinhala",
+		Slv: "Tiếng Slovenia",
+		Sna: "Tiếng Shona",
+		Som: "Tiếng Somali",
+		Spa: "Tiếng Tây Ban Nha",
+		Srp: "Tiếng Serbia",
+		Swe: "Tiếng Thụy Điển",
+		Tam: "Tiếng Tamil",
+		Tel: "Tiếng Telugu",
+		Tgl: "Tiếng Philippines",
+		Tha: "Tiếng Thái",
+		Tir: "Tiếng Tigrinya",
+		Tuk: "Tiếng Turkmen",
+		Tur: "Tiếng Thổ Nhĩ Kỳ",
+		Uig: "Tiếng Uyghur",
+		Ukr: "Tiếng Ucraina",
+		Urd: "Tiếng Urdu",
+		Uzb: "Tiếng Uzbek",
+		Vie: "Tiếng Việt",
+		Ydd: "Tiếng Yiddish",
+		Yor: "Tiếng Yoruba",
+		Zul: "Tiếng Zulu",
+	},
+	Ydd: {
+		Afr: "אַפֿריקאַנס",
+		Amh: "אַמהאַריש",
+		Arb: "אַראַביש",
+		Azj: "אַזערביידזשאַניש",
+		Bel: "בעלאַרוסיש",
+		Ben: "בענגאַליש",
+		Bul: "בולגאַריש",
+		Ceb: "סעבואַניש",
+		Ces: "טשעכיש",
+		Cmn: "כינעזיש",
+		Dan: "דעניש",
+		Deu: "דײַטש",
+		Ell: "גריכיש",
+		Eng: "ענגליש",
+		Epo: "עספּעראַנטא",
+		Est: "עסטיש",
+		Fin: "פֿיניש",
+		Fra: "פֿראַנצויזיש",
+		Hau: "האַוסאַ",
+		Heb: "העברעאיש",
+		Hin: "הינדי",
+		Hrv: "קראאַטיש",
+		Hun: "אונגעריש",
+		Ind: "אינדאנעזיש",
+		Ita: "איטאַליעניש",
+		Jav: "יאַוואַנעזיש",
+		Jpn: "יאַפּאַניש",
+		Kan: "קאַנאַדאַ",
+		Kat: "גרוזיניש",
+		Khm: "כמער",
+		Kor: "קארעאיש",
+		Kur: "קורדיש",
+		Lav: "לעטיש",
+		Lit: "ליטוויש",
+		Mal: "מאַלאַיאַלאַם",
+		Mkd: "מאַקעדאניש",
+		Mya: "בירמאַניש",
+		Nep: "נעפּאַליש",
+		Nld: "האלענדיש",
+		Nno: "נײַ־נארוועגיש",
+		Nob: "נארוועגיש",
+		Pes: "פּערסיש",
+		Pol: "פּויליש",
+		Por: "פּארטוגעזיש",
+		Ron: "רומעניש",
+		Rus: "רוסיש",
+		Sin: "סינהאַליש",
+		Slv: "סלאוועניש",
+		Sna: "שאנאַ",
+		Som: "סאמאַליש",
+		Spa: "שפּאַניש",
+		Srp: "סערביש",
+		Swe: "שוועדיש",
+		Tam: "טאַמיל",
+		Tgl: "פֿיליפּינא",
+		Tuk: "טורקמעניש",
+		Ukr: "אוקראַאיניש",
+		Urd: "אורדו",
+		Uzb: "אוזבעקיש",
+		Vie: "וויעטנאַמעזיש",
+		Ydd: "ייִדיש",
+		Zul: "זולו",
+	},
+	Yor: {
+		Afr: "Èdè Afrikani",
+		Aka: "Èdè Akani",
+		Amh: "Èdè Amariki",
+		Arb: "Èdè Arabiki",
+		Azj: "Èdè Azerbaijani",
+		Bel: "Èdè Belarusi",
+		Ben: "Èdè Bengali",
+		Bul: "Èdè Bugaria",
+		Ces: "Èdè seeki",
+		Cmn: "Èdè Mandari",
+		Dan: "Èdè Ilẹ̀ Denmark",
+		Deu: "Èdè Ilẹ̀ Gemani",
+		Ell: "Èdè Giriki",
+		Eng: "Èdè Gẹ̀ẹ́sì",
+		Epo: "Èdè Esperanto",
+		Est: "Èdè Estonia",
+		Fin: "Èdè Finisi",
+		Fra: "Èdè Faransé",
+		Guj: "Èdè Gujarati",
+		Hau: "Èdè Hausa",
+		Heb: "Èdè Heberu",
+		Hin: "Èdè Hindi",
+		Hrv: "Èdè Kroatia",
+		Hun: "Èdè Hungaria",
+		Ibo: "Èdè Ibo",
+		Ind: "Èdè Indonasia",
+		Ita: "Èdè Italiani",
+		Jav: "Èdè Javanasi",
+		Jpn: "Èdè Japanisi",
+		Kan: "Èdè Kannada",
+		Kat: "Èdè Georgia",
+		Khm: "Èdè kameri",
+		Kin: "Èdè Ruwanda",
+		Kor: "Èdè Koria",
+		Lav: "Èdè Latvianu",
+		Lit: "Èdè Lithuania",
+		Mar: "Èdè marathi",
+		Mkd: "Èdè Macedonia",
+		Mya: "Èdè Bumiisi",
+		Nep: "Èdè Nepali",
+		Nld: "Èdè Duki",
+		Nob: "Èdè Norway",
+		Pan: "Èdè Punjabi",
+		Pes: "Èdè Pasia",
+		Pol: "Èdè Ilẹ̀ Polandi",
+		Por: "Èdè Pọtugi",
+		Ron: "Èdè Romania",
+		Rus: "Èdè ̣Rọọsia",
+		Sin: "Èdè Sinhalese",
+		Slv: "Èdè Slovenia",
+		Som: "Èdè ara Somalia",
+		Spa: "Èdè Sipanisi",
+		Srp: "Èdè Serbia",
+		Swe: "Èdè Suwidiisi",
+		Tam: "Èdè Tamili",
+		Tel: "Èdè Telugu",
+		Tgl: "Èdè Filipino",
+		Tha: "Èdè Tai",
+		Tir: "Èdè Tigrinya",
+		Tuk: "Èdè Turkmen",
+		Tur: "Èdè Tọọkisi",
+		Ukr: "Èdè Ukania",
+		Urd: "Èdè Udu",
+		Uzb: "Èdè Uzbek",
+		Vie: "Èdè Jetinamu",
+		Ydd: "Èdè Yiddishi",
+		Yor: "Èdè Yorùbá",
+		Zul: "Èdè Ṣulu",
+	},
+	Zul: {
+		Afr: "i-Afrikaans",
+		Aka: "isi-Akan",
+		Amh: "isi-Amharic",
+		Arb: "isi-Arabic",
+		Azj: "isi-Azerbaijani",
+		Bel: "isi-Belarusian",
+		Ben: "isi-Bengali",
+		Bho: "isi-Bhojpuri",
+		Bul: "isi-Bulgari",
+		Ceb: "isi-Cebuano",
+		Ces: "isi-Czech",
+		Cmn: "isi-Chinese",
+		Dan: "isi-Danish",
+		Deu: "isi-German",
+		Ell: "isi-Greek",
+		Eng: "i-English",
+		Epo: "isi-Esperanto",
+		Est: "isi-Estonia",
+		Fin: "isi-Finnish",
+		Fra: "isi-French",
+		Guj: "isi-Gujarati",
+		Hat: "isi-Haitian",
+		Hau: "isi-Hausa",
+		Heb: "isi-Hebrew",
+		Hin: "isi-Hindi",
+		Hrv: "isi-Croatian",
+		Hun: "isi-Hungarian",
+		Ibo: "isi-Igbo",
+		Ilo: "isi-Iloko",
+		Ind: "isi-Indonesian",
+		Ita: "isi-Italian",
+		Jav: "isi-Javanese",
+		Jpn: "isi-Japanese",
+		Kan: "isi-Kannada",
+		Kat: "isi-Georgian",
+		Khm: "isi-Khmer",
+		Kin: "isi-Kinyarwanda",
+		Kor: "isi-Korean",
+		Kur: "isi-Kurdish",
+		Lav: "isi-Latvian",
+		Lit: "isi-Lithuanian",
+		Mai: "isi-Maithili",
+		Mal: "isi-Malayalam",
+		Mar: "isi-Marathi",
+		Mkd: "isi-Macedonian",
+		Mlg: "isi-Malagasy",
+		Mya: "isi-Burmese",
+		Nep: "isi-Nepali",
+		Nld: "isi-Dutch",
+		Nno: "i-Norwegian Nynorsk",
+		Nob: "isi-Norwegian Bokmål",
+		Nya: "isi-Nyanja",
+		Ori: "isi-Odia",
+		Orm: "i-Oromo",
+		Pan: "isi-Punjabi",
+		Pes: "isi-Persian",
+		Pol: "isi-Polish",
+		Por: "isi-Portuguese",
+		Ron: "isi-Romanian",
+		Run: "isi-Rundi",
+		Rus: "isi-Russian",
+		Sin: "i-Sinhala",
+		Slv: "isi-Slovenian",
+		Sna: "isiShona",
+		Som: "isi-Somali",
+		Spa: "isi-Spanish",
+		Srp: "isi-Serbian",
+		Swe: "isi-Swedish",
+		Tam: "isi-Tamil",
+		Tel: "isi-Telugu",
+		Tgl: "isi-Filipino",
+		Tha: "isi-Thai",
+		Tir: "isi-Tigrinya",
+		Tuk: "isi-Turkmen",
+		Tur: "isi-Turkish",
+		Uig: "isi-Uighur",
+		Ukr: "isi-Ukrainian",
+		Urd: "isi-Urdu",
+		Uzb: "isi-Uzbek",
+		Vie: "isi-Vietnamese",
+		Ydd: "isi-Yiddish",
+		Yor: "isi-Yoruba",
+		Zul: "isiZulu",
+	},
+}
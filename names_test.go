@@ -0,0 +1,54 @@
+package whatlanggo
+
+import "testing"
+
+func TestLangNameIn(t *testing.T) {
+	tests := []struct {
+		lang    Lang
+		display Lang
+		want    string
+	}{
+		{Deu, Fra, "allemand"},
+		{Fra, Deu, "Französisch"},
+		{Eng, Spa, "inglés"},
+		{Rus, Rus, "русский"},
+		{Jpn, Jpn, "日本語"},
+		{Cmn, Fra, "chinois"},
+		{Deu, Eng, "German"},
+		{Nob, Eng, "Bokmal"},
+		//CLDR has the names of Bokmål and Tagalog as "no" and "fil".
+		{Deu, Nob, "tysk"},
+		{Eng, Tgl, "Ingles"},
+		//Missing names and display languages fall back to English.
+		{Skr, Fra, "Saraiki"},
+		{Deu, Skr, "German"},
+		{Deu, Und, "German"},
+		{Und, Fra, "Undetermined"},
+	}
+
+	for _, tt := range tests {
+		got := tt.lang.NameIn(tt.display)
+		if got != tt.want {
+			t.Fatalf("%s in %s: want %s got %s", LangToString(tt.lang), LangToString(tt.display), tt.want, got)
+		}
+	}
+}
+
+func TestLocalizedNames(t *testing.T) {
+	for display, names := range localizedNames {
+		if !display.IsValid() {
+			t.Fatalf("invalid display language %d", display)
+		}
+		for lang, name := range names {
+			if !lang.IsValid() || name == "" {
+				t.Fatalf("%s: got %q for %d", LangToString(display), name, lang)
+			}
+		}
+		if names[display] == "" {
+			t.Fatalf("%s: no name for itself", LangToString(display))
+		}
+	}
+	if len(localizedNames) < 50 {
+		t.Fatalf("got %d display languages", len(localizedNames))
+	}
+}
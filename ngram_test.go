@@ -0,0 +1,101 @@
+package whatlanggo
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestTrainNgramModel(t *testing.T) {
+	examples := readExamples(t)
+
+	corpus := map[Lang][]string{}
+	for code, text := range examples {
+		corpus[CodeToLang(code)] = []string{text}
+	}
+
+	for _, config := range [][2]int{{2, 100}, {3, 100}, {4, 300}, {5, 300}} {
+		n, depth := config[0], config[1]
+		model, err := TrainNgramModel(corpus, n, depth)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if model.Order() != n || model.Depth() != depth {
+			t.Fatalf("want order %d and depth %d got %d and %d", n, depth, model.Order(), model.Depth())
+		}
+
+		//Windows of the training texts are recognized.
+		options := Options{Model: model}
+		for lang, texts := range corpus {
+			if _, ok := scriptProfiles[DetectScript(texts[0])]; !ok {
+				continue
+			}
+			words := strings.Fields(texts[0])
+			window := strings.Join(words[:len(words)/2], " ")
+			if got := DetectLangWithOptions(window, options); got != lang {
+				t.Fatalf("%d-grams, %q: want %v got %v", n, window, LangToString(lang), LangToString(got))
+			}
+		}
+	}
+
+	for _, config := range [][2]int{{1, 300}, {6, 300}, {3, 0}} {
+		if _, err := TrainNgramModel(corpus, config[0], config[1]); err == nil {
+			t.Fatalf("%v: want an error", config)
+		}
+	}
+	if _, err := TrainNgramModel(map[Lang][]string{Fra: {"..."}}, 3, 300); err == nil {
+		t.Fatal("want an error for a corpus without n-grams")
+	}
+}
+
+func TestNewNgramModel(t *testing.T) {
+	trained, err := TrainNgramModel(map[Lang][]string{
+		Fra: {"Je ne sais pas ce que je vais faire demain."},
+		Deu: {"Ich weiß nicht, was ich morgen machen werde."},
+	}, 4, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	model, err := NewNgramModel(trained.Profiles(), 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(model, trained) {
+		t.Fatalf("want %v got %v", trained, model)
+	}
+
+	invalid := []map[Lang][]string{
+		{Fra: {}},
+		{Fra: {" je ", "je"}},
+	}
+	for _, profiles := range invalid {
+		if _, err := NewNgramModel(profiles, 4); err == nil {
+			t.Fatalf("%v: want an error", profiles)
+		}
+	}
+	if _, err := NewNgramModel(trained.Profiles(), 6); err == nil {
+		t.Fatal("want an error for an invalid order")
+	}
+}
+
+func TestNgramModelShortText(t *testing.T) {
+	model, err := TrainNgramModel(map[Lang][]string{
+		Fra: {"Je ne sais pas ce que je vais faire demain."},
+		Deu: {"Ich weiß nicht, was ich morgen machen werde."},
+	}, 4, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//Models follow the short-text threshold of the options.
+	text := "Je ne sais"
+	for _, model := range []Model{model, TrigramModel{}} {
+		short := model.Score(newFeatures(text, unicode.Latin, Options{}), []Lang{Deu, Fra})
+		disabled := model.Score(newFeatures(text, unicode.Latin, Options{ShortTextTrigrams: -1}), []Lang{Deu, Fra})
+		if reflect.DeepEqual(short, disabled) {
+			t.Fatalf("%T: want other scores without the short-text model got %v", model, short)
+		}
+	}
+}
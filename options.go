@@ -1,8 +1,115 @@
 package whatlanggo
 
+import "math"
+
 // Options represents options that can be set when detecting a language or/and script such
 // blacklisting languages to skip checking.
 type Options struct {
 	Whitelist map[Lang]bool
 	Blacklist map[Lang]bool
+
+	// ScriptFallback makes detection return an allowed language even when no language
+	// written in the dominant script of the text is allowed, which is mostly useful
+	// with a whitelist. The other scripts of the text are tried instead, and the
+	// confidence is lowered according to how little of the text they cover. When no
+	// script of the text has allowed languages, detection returns the allowed language
+	// with the highest prior, with a confidence of 0 and ReasonScriptMismatch.
+	ScriptFallback bool
+
+	// ReliabilityThreshold is the confidence Options.IsReliable requires instead of
+	// ReliableConfidenceThreshold. It is not used when it is 0.
+	ReliabilityThreshold float64
+	// LangThresholds sets the confidence Options.IsReliable requires for specific
+	// languages, including 0. It takes precedence over ReliabilityThreshold.
+	LangThresholds map[Lang]float64
+	// RejectUnreliable makes detection return Und with ReasonBelowThreshold instead of
+	// a language whose detection is not reliable.
+	RejectUnreliable bool
+
+	// Priors are relative weights of the languages the text is expected to be written
+	// in, such as 3 for Por and 1 for Spa and Glg in a Portuguese-market app. Languages
+	// without a positive weight have a weight of 1. Priors are combined with the
+	// trigram evidence following the calibration, so that a weight of 3 triples the odds
+	// of a language, and they matter less and less as the text gets longer.
+	Priors map[Lang]float64
+
+	// ShortTextTrigrams is the number of distinct trigrams under which texts, such as
+	// search queries and chat messages, are detected with the short-text model, which
+	// ignores the ranks of trigrams in the text. The confidence of short texts is scaled
+	// down by the lack of evidence. 0 means DefaultShortTextTrigrams, and a negative
+	// value disables the short-text model.
+	ShortTextTrigrams int
+
+	// Model scores the languages of scripts shared by several languages. nil means
+	// TrigramModel, the fastest model.
+	Model Model
+
+	// Calibration is used by Probabilities and Priors instead of DefaultCalibration when
+	// it is not nil.
+	Calibration *Calibration
+
+	// MaxRunes bounds the number of runes analyzed in long texts, such as books, so that
+	// detection takes bounded time: the runes of longer texts are sampled according to
+	// Sampling. Info.AnalyzedRunes reports how many runes were analyzed. 0 means that
+	// the whole text is analyzed.
+	MaxRunes int
+	// Sampling picks the runes analyzed in texts longer than MaxRunes.
+	Sampling Sampling
+
+	// Workers is the number of goroutines DetectBatch and DetectStream detect texts on.
+	// 0 means runtime.GOMAXPROCS(0).
+	Workers int
+}
+
+// isAllowed reports whether lang may be returned given the whitelist and blacklist.
+// A non-empty whitelist takes precedence over the blacklist.
+func (options Options) isAllowed(lang Lang) bool {
+	if len(options.Whitelist) != 0 {
+		_, ok := options.Whitelist[lang]
+		return ok
+	}
+	if len(options.Blacklist) != 0 {
+		_, ok := options.Blacklist[lang]
+		return !ok
+	}
+	return true
+}
+
+// IsReliable returns true if the confidence of info is greater than the reliability
+// threshold options set for its language, or than the Reliable Confidence Threshold
+// when options set none.
+func (options Options) IsReliable(info Info) bool {
+	threshold, ok := options.threshold(info.Lang)
+	if !ok {
+		return info.IsReliable()
+	}
+	return info.Confidence > threshold
+}
+
+// threshold returns the reliability threshold of lang, and false when
+// ReliableConfidenceThreshold is used. A threshold of 0 in LangThresholds is kept.
+func (options Options) threshold(lang Lang) (float64, bool) {
+	if threshold, ok := options.LangThresholds[lang]; ok {
+		return threshold, true
+	}
+	if options.ReliabilityThreshold != 0 {
+		return options.ReliabilityThreshold, true
+	}
+	return 0, false
+}
+
+// calibration returns the calibration that maps trigram distances to probabilities.
+func (options Options) calibration() Calibration {
+	if options.Calibration != nil {
+		return *options.Calibration
+	}
+	return DefaultCalibration
+}
+
+// logPrior returns the logarithm of the prior weight of lang.
+func (options Options) logPrior(lang Lang) float64 {
+	if prior := options.Priors[lang]; prior > 0 {
+		return math.Log(prior)
+	}
+	return 0
 }
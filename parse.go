@@ -0,0 +1,122 @@
+package whatlanggo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// langAliases maps lowercase codes and names that are not part of Metadata to the
+// language they refer to.
+var langAliases = map[string]Lang{
+	// ISO 639-1 codes of macrolanguages and deprecated ISO 639-1 codes.
+	"fa": Pes,
+	"yi": Ydd,
+	"no": Nob,
+	"iw": Heb,
+	"in": Ind,
+	"ji": Ydd,
+
+	// ISO 639-3 code of a macrolanguage, mapped to the member language that is supported.
+	// The other ones are ISO 639-2 codes as well.
+	"nor": Nob,
+
+	// ISO 639-3 codes of individual languages, for the macrolanguages that are supported.
+	"ekk": Est,
+	"gaz": Orm,
+	"kmr": Kur,
+	"lvs": Lav,
+	"npi": Nep,
+	"ory": Ori,
+	"plt": Mlg,
+	"twi": Aka,
+	"uzn": Uzb,
+	"fil": Tgl,
+
+	// Alternative English names.
+	"azeri":             Azj,
+	"castilian":         Spa,
+	"chichewa":          Nya,
+	"chinese":           Cmn,
+	"farsi":             Pes,
+	"filipino":          Tgl,
+	"flemish":           Nld,
+	"haitian":           Hat,
+	"kirundi":           Run,
+	"moldovan":          Ron,
+	"norwegian":         Nob,
+	"norwegian bokmal":  Nob,
+	"norwegian bokmål":  Nob,
+	"bokmål":            Nob,
+	"norwegian nynorsk": Nno,
+	"nyanja":            Nya,
+	"odia":              Ori,
+	"panjabi":           Pan,
+	"sinhala":           Sin,
+	"slovenian":         Slv,
+	"uighur":            Uig,
+}
+
+// parseLangs maps every lowercase code and name accepted by ParseLang to its language.
+var parseLangs = func() map[string]Lang {
+	langs := map[string]Lang{"und": Und}
+	for lang, name := range Langs {
+		langs[strings.ToLower(name)] = lang
+		langs[lang.Iso6393()] = lang
+		if code := lang.Iso6391(); code != "" {
+			langs[code] = lang
+		}
+		m := langMetadata[lang]
+		langs[strings.ToLower(m.NativeName)] = lang
+		if m.Iso6392B != "" {
+			langs[m.Iso6392B] = lang
+			langs[m.Iso6392T] = lang
+		}
+	}
+	for alias, lang := range langAliases {
+		langs[alias] = lang
+	}
+	return langs
+}()
+
+// ParseLang gets the language from an ISO 639-1, ISO 639-2/B, ISO 639-2/T or ISO 639-3
+// code, a BCP 47 language tag such as "pt-BR" or "zh-Hant-TW", a POSIX locale such as
+// "pt_BR.UTF-8", or the English or native name of the language. Parsing is
+// case-insensitive. Macrolanguages are mapped to the member language that whatlanggo
+// supports, e.g. "zh" gives Cmn, "ar" gives Arb, "fa" gives Pes and "az" gives Azj.
+// "und" gives Und. An error is returned when the language is not supported.
+func ParseLang(s string) (Lang, error) {
+	key := strings.ToLower(strings.TrimSpace(s))
+	if lang, ok := parseLangs[key]; ok {
+		return lang, nil
+	}
+
+	// Drop the encoding and modifier of POSIX locales.
+	if i := strings.IndexAny(key, ".@"); i >= 0 {
+		key = key[:i]
+	}
+
+	subtags := strings.Split(strings.Replace(key, "_", "-", -1), "-")
+	if len(subtags) > 1 && len(subtags[1]) == 3 && isASCIILetters(subtags[1]) {
+		// Extended language subtag, as in "zh-cmn-Hans".
+		if lang, ok := parseLangs[subtags[1]]; ok {
+			return lang, nil
+		}
+	}
+	if len(subtags[0]) == 2 || len(subtags[0]) == 3 {
+		if lang, ok := parseLangs[subtags[0]]; ok {
+			return lang, nil
+		}
+	}
+
+	return Und, fmt.Errorf("whatlanggo: unknown language %q", s)
+}
+
+// isASCIILetters returns true if s contains only ASCII letters.
+func isASCIILetters(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') {
+			return false
+		}
+	}
+	return true
+}
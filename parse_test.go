@@ -0,0 +1,81 @@
+package whatlanggo
+
+import "testing"
+
+func TestParseLang(t *testing.T) {
+	tests := map[string]Lang{
+		// ISO 639-3
+		"eng": Eng,
+		"DEU": Deu,
+		"und": Und,
+		// ISO 639-1
+		"en": Eng,
+		"FR": Fra,
+		"nb": Nob,
+		"zh": Cmn,
+		"ar": Arb,
+		"fa": Pes,
+		"az": Azj,
+		"no": Nob,
+		// ISO 639-2/B and /T
+		"ger": Deu,
+		"fre": Fra,
+		"chi": Cmn,
+		"zho": Cmn,
+		"per": Pes,
+		"fas": Pes,
+		// BCP 47
+		"pt-BR":       Por,
+		"zh-Hant-TW":  Cmn,
+		"zh-cmn-Hans": Cmn,
+		"sr-Latn-RS":  Srp,
+		"es-419":      Spa,
+		"az-Cyrl":     Azj,
+		// POSIX locales
+		"pt_BR":       Por,
+		"de_DE.UTF-8": Deu,
+		// Names
+		"French":         Fra,
+		"haitian creole": Hat,
+		" Farsi ":        Pes,
+		"Bokmål":         Nob,
+		"Deutsch":        Deu,
+		"русский":        Rus,
+		"en-":            Eng,
+	}
+
+	for s, want := range tests {
+		got, err := ParseLang(s)
+		if err != nil {
+			t.Fatalf("%q: %v", s, err)
+		}
+		if got != want {
+			t.Fatalf("%q: want %v got %v", s, LangToString(want), LangToString(got))
+		}
+	}
+}
+
+func TestParseLangUnknown(t *testing.T) {
+	tests := []string{"", "xx", "xxx", "ca_ES@euro", "Klingon", "-en"}
+
+	for _, s := range tests {
+		got, err := ParseLang(s)
+		if err == nil || got != Und {
+			t.Fatalf("%q: want error got %v", s, LangToString(got))
+		}
+	}
+}
+
+func TestParseLangRoundTrip(t *testing.T) {
+	for lang := range Langs {
+		for _, s := range []string{lang.Iso6393(), lang.Iso6391(), lang.String()} {
+			if s == "" {
+				continue
+			}
+			got, err := ParseLang(s)
+			if err != nil || got != lang {
+				t.Fatalf("%q: want %v got %v %v", s, LangToString(lang), LangToString(got), err)
+			}
+		}
+	}
+}
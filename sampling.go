@@ -0,0 +1,87 @@
+package whatlanggo
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Sampling is the strategy that picks the runes analyzed in texts longer than
+// Options.MaxRunes.
+type Sampling int
+
+const (
+	// SampleHead analyzes the first runes of the text.
+	SampleHead Sampling = iota
+	// SampleWindows analyzes windows spread evenly over the text, so that a title, a
+	// table of contents or a preface in another language weigh less than with
+	// SampleHead.
+	SampleWindows
+)
+
+const (
+	// sampleWindows is the number of windows of SampleWindows.
+	sampleWindows = 8
+	// minSampleWindowRunes is the number of runes under which SampleWindows uses fewer
+	// windows, so that windows hold several words.
+	minSampleWindowRunes = 64
+)
+
+// sample returns the part of text that is analyzed, the number of runes of that part,
+// and whether it is only a sample of text. SampleHead only reads as many runes as it
+// keeps, and SampleWindows only counts the runes of text, without copying them, to
+// spread its windows.
+func (options Options) sample(text string) (string, int, bool) {
+	if options.MaxRunes <= 0 {
+		return text, utf8.RuneCountInString(text), false
+	}
+
+	end, runes := runePrefix(text, options.MaxRunes)
+	if end == len(text) {
+		return text, runes, false
+	}
+	if options.Sampling != SampleWindows {
+		return text[:end], runes, true
+	}
+
+	windows := options.MaxRunes / minSampleWindowRunes
+	if windows > sampleWindows {
+		windows = sampleWindows
+	}
+	if windows < 2 {
+		return text[:end], runes, true
+	}
+
+	// Windows start every total/windows runes and are separated by spaces, so that no
+	// trigram spans two windows. They do not overlap, since text has more than MaxRunes
+	// runes.
+	var sample strings.Builder
+	total := utf8.RuneCountInString(text)
+	windowRunes := options.MaxRunes / windows
+	pos, posRunes := 0, 0
+	runes = 0
+	for i := 0; i < windows; i++ {
+		skip, skipped := runePrefix(text[pos:], i*total/windows-posRunes)
+		pos, posRunes = pos+skip, posRunes+skipped
+		end, n := runePrefix(text[pos:], windowRunes)
+		if i > 0 {
+			sample.WriteByte(' ')
+		}
+		sample.WriteString(text[pos : pos+end])
+		pos, posRunes = pos+end, posRunes+n
+		runes += n
+	}
+	return sample.String(), runes, true
+}
+
+// runePrefix returns the length in bytes and in runes of the longest prefix of text that
+// holds at most maxRunes runes.
+func runePrefix(text string, maxRunes int) (int, int) {
+	runes := 0
+	for i := range text {
+		if runes == maxRunes {
+			return i, runes
+		}
+		runes++
+	}
+	return len(text), runes
+}
@@ -0,0 +1,75 @@
+package whatlanggo
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDetectMaxRunes(t *testing.T) {
+	examples := readExamples(t)
+
+	//Samples of long texts are detected like the whole texts.
+	for _, sampling := range []Sampling{SampleHead, SampleWindows} {
+		options := Options{MaxRunes: 500, Sampling: sampling}
+		for code, example := range examples {
+			book := strings.Repeat(example+"\n", 20)
+			want := Detect(book)
+			if want.Lang != CodeToLang(code) {
+				continue
+			}
+
+			info := DetectWithOptions(book, options)
+			if info.Lang != want.Lang {
+				t.Fatalf("%s %v: want %v got %v", code, sampling, LangToString(want.Lang), LangToString(info.Lang))
+			}
+			if sampled := utf8.RuneCountInString(book) > options.MaxRunes; info.Sampled != sampled || sampled && info.AnalyzedRunes > options.MaxRunes {
+				t.Fatalf("%s %v: want a sample of at most %d runes got %d", code, sampling, options.MaxRunes, info.AnalyzedRunes)
+			}
+		}
+	}
+
+	//Texts with no more runes than MaxRunes are analyzed whole, whatever their length in bytes.
+	text := "Мы не знаем, что будет завтра."
+	runes := utf8.RuneCountInString(text)
+	info := DetectWithOptions(text, Options{MaxRunes: runes})
+	if info.Sampled || info.AnalyzedRunes != runes {
+		t.Fatalf("want %d runes analyzed without sampling got %v", runes, info)
+	}
+	if info := Detect(text); info.Sampled || info.AnalyzedRunes != runes {
+		t.Fatalf("want %d runes analyzed without sampling got %v", runes, info)
+	}
+}
+
+func TestSampleWindows(t *testing.T) {
+	//A preface in English is left out of most windows of a French book.
+	preface := strings.Repeat("This translation was first published in London by a small press. ", 8)
+	body := strings.Repeat("Je ne sais pas ce que je vais faire demain, mais je sais que je serai heureux. ", 40)
+	book := preface + body
+
+	if got := DetectLangWithOptions(book, Options{MaxRunes: 512, Sampling: SampleHead}); got != Eng {
+		t.Fatalf("want %v got %v", LangToString(Eng), LangToString(got))
+	}
+	if got := DetectLangWithOptions(book, Options{MaxRunes: 512, Sampling: SampleWindows}); got != Fra {
+		t.Fatalf("want %v got %v", LangToString(Fra), LangToString(got))
+	}
+
+	//Windows start and end on rune boundaries.
+	text := strings.Repeat("Ĉu vi parolas Esperanton? ", 100)
+	for _, maxRunes := range []int{1, 100, 128, 333, 1000} {
+		sample, runes, sampled := Options{MaxRunes: maxRunes, Sampling: SampleWindows}.sample(text)
+		if !sampled || !utf8.ValidString(sample) || runes > maxRunes {
+			t.Fatalf("%d: invalid sample of %d runes %q", maxRunes, runes, sample)
+		}
+		if got := utf8.RuneCountInString(strings.Replace(sample, " ", "", -1)); got > runes {
+			t.Fatalf("%d: want at most %d runes got %d", maxRunes, runes, got)
+		}
+	}
+
+	//Windows are spread over runes, not bytes.
+	text = strings.Repeat("ж", 1000) + strings.Repeat("a", 1000)
+	sample, _, _ := Options{MaxRunes: 512, Sampling: SampleWindows}.sample(text)
+	if cyrillic := strings.Count(sample, "ж"); cyrillic != 256 || strings.Count(sample, "a") != 256 {
+		t.Fatalf("want 4 windows of 64 runes of each script got %d Cyrillic runes in %q", cyrillic, sample)
+	}
+}
@@ -1,6 +1,10 @@
 package whatlanggo
 
-import "unicode"
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
 
 type scriptCounter struct {
 	checkFunc func(r rune) bool
@@ -36,11 +40,55 @@ var Scripts = map[*unicode.RangeTable]string{
 	unicode.Thai:       "Thai",
 }
 
-// DetectScript returns only the script of the given text.
-func DetectScript(text string) *unicode.RangeTable {
-	halfLen := len(text) / 2
+// scriptCodes maps the supported scripts to their ISO 15924 codes.
+var scriptCodes = map[*unicode.RangeTable]string{
+	unicode.Arabic:     "Arab",
+	unicode.Bengali:    "Beng",
+	unicode.Cyrillic:   "Cyrl",
+	unicode.Ethiopic:   "Ethi",
+	unicode.Devanagari: "Deva",
+	unicode.Han:        "Hani",
+	unicode.Georgian:   "Geor",
+	unicode.Greek:      "Grek",
+	unicode.Gujarati:   "Gujr",
+	unicode.Gurmukhi:   "Guru",
+	unicode.Hangul:     "Hang",
+	unicode.Hebrew:     "Hebr",
+	unicode.Hiragana:   "Hira",
+	unicode.Kannada:    "Knda",
+	unicode.Katakana:   "Kana",
+	unicode.Khmer:      "Khmr",
+	unicode.Latin:      "Latn",
+	unicode.Malayalam:  "Mlym",
+	unicode.Myanmar:    "Mymr",
+	unicode.Oriya:      "Orya",
+	unicode.Sinhala:    "Sinh",
+	unicode.Tamil:      "Taml",
+	unicode.Telugu:     "Telu",
+	unicode.Thai:       "Thai",
+	_HiraganaKatakana:  "Hrkt",
+}
+
+// ScriptCode returns the ISO 15924 code of script, such as "Latn" or "Cyrl".
+// Returns empty string when script is not supported.
+func ScriptCode(script *unicode.RangeTable) string {
+	return scriptCodes[script]
+}
+
+// CodeToScript gets the script by its ISO 15924 code. The code is case-insensitive.
+// Returns nil when there is no supported script with this code.
+func CodeToScript(code string) *unicode.RangeTable {
+	for script, scriptCode := range scriptCodes {
+		if strings.EqualFold(scriptCode, code) {
+			return script
+		}
+	}
+	return nil
+}
 
-	scriptCounter := []scriptCounter{
+// newScriptCounters returns a counter for each supported script.
+func newScriptCounters() []scriptCounter {
+	return []scriptCounter{
 		{isLatin, unicode.Latin, 0},
 		{isCyrillic, unicode.Cyrillic, 0},
 		{isArabic, unicode.Arabic, 0},
@@ -65,6 +113,34 @@ func DetectScript(text string) *unicode.RangeTable {
 		{isSinhala, unicode.Sinhala, 0},
 		{isKhmer, unicode.Khmer, 0},
 	}
+}
+
+// countScripts counts the characters of every supported script in text and returns
+// the counters sorted from the most to the least frequent script.
+func countScripts(text string) []scriptCounter {
+	scriptCounter := newScriptCounters()
+
+	for _, ch := range text {
+		if isStopChar(ch) {
+			continue
+		}
+
+		for i, sc := range scriptCounter {
+			if sc.checkFunc(ch) {
+				scriptCounter[i].count++
+			}
+		}
+	}
+
+	sort.SliceStable(scriptCounter, func(i, j int) bool { return scriptCounter[i].count > scriptCounter[j].count })
+	return scriptCounter
+}
+
+// DetectScript returns only the script of the given text.
+func DetectScript(text string) *unicode.RangeTable {
+	halfLen := len(text) / 2
+
+	scriptCounter := newScriptCounters()
 
 	for _, ch := range text {
 		if isStopChar(ch) {
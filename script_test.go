@@ -213,3 +213,38 @@ func TestIsOriya(t *testing.T) {
 		}
 	}
 }
+
+func TestCountScripts(t *testing.T) {
+	counters := countScripts("Привет! Текст на русском with some English.")
+	if counters[0].script != unicode.Cyrillic || counters[0].count != 20 {
+		t.Fatalf("want %s 20 got %s %d", Scripts[unicode.Cyrillic], Scripts[counters[0].script], counters[0].count)
+	}
+	if counters[1].script != unicode.Latin || counters[1].count != 15 {
+		t.Fatalf("want %s 15 got %s %d", Scripts[unicode.Latin], Scripts[counters[1].script], counters[1].count)
+	}
+	if counters[2].count != 0 {
+		t.Fatalf("want 0 got %s %d", Scripts[counters[2].script], counters[2].count)
+	}
+}
+
+func TestScriptCode(t *testing.T) {
+	for script := range Scripts {
+		code := ScriptCode(script)
+		if len(code) != 4 {
+			t.Fatalf("%s: got %q", Scripts[script], code)
+		}
+		if got := CodeToScript(code); got != script {
+			t.Fatalf("%s: want %s got %s", code, Scripts[script], Scripts[got])
+		}
+	}
+
+	if got := CodeToScript("cyrl"); got != unicode.Cyrillic {
+		t.Fatalf("want %s got %s", Scripts[unicode.Cyrillic], Scripts[got])
+	}
+	if got := ScriptCode(_HiraganaKatakana); got != "Hrkt" {
+		t.Fatalf("want Hrkt got %s", got)
+	}
+	if got := CodeToScript("Xxxx"); got != nil {
+		t.Fatalf("want nil got %s", Scripts[got])
+	}
+}
@@ -0,0 +1,65 @@
+package whatlanggo
+
+// DefaultShortTextTrigrams is the number of distinct trigrams under which a text is
+// detected with the short-text model when Options.ShortTextTrigrams is 0. It
+// corresponds to about 40 characters, the length of a search query or a chat message.
+const DefaultShortTextTrigrams = 40
+
+// shortTextWordEvidence is the number of trigrams a known word is worth when the
+// confidence of a short text is scaled down for the lack of evidence.
+const shortTextWordEvidence = 4
+
+// isShortText reports whether a text with trigramCount distinct trigrams is detected
+// with the short-text model.
+func (options Options) isShortText(trigramCount int) bool {
+	return trigramCount < options.shortTextTrigrams()
+}
+
+// shortTextTrigrams returns the number of trigrams under which texts are short.
+func (options Options) shortTextTrigrams() int {
+	if options.ShortTextTrigrams == 0 {
+		return DefaultShortTextTrigrams
+	}
+	return options.ShortTextTrigrams
+}
+
+// calculateShortTextDistance is calculateDistance for short texts. Most trigrams of a
+// short text occur once, so that their rank in the text carries no information: a
+// trigram of the text costs half its rank in the language profile instead.
+func calculateShortTextDistance(langTrigrams []uint64, textTrigrams map[uint64]int) int {
+	var totalDist int
+	for i, trigram := range langTrigrams {
+		if _, ok := textTrigrams[trigram]; ok {
+			totalDist += i / 2
+		} else {
+			totalDist += maxTrigramDistance
+		}
+	}
+	return totalDist
+}
+
+// calculateShortTextNgramDistance is calculateShortTextDistance for n-grams of any
+// order given as strings, where an n-gram missing from the text adds maxDistance.
+func calculateShortTextNgramDistance(langNgrams []string, textNgrams map[string]int, maxDistance int) int {
+	var totalDist int
+	for i, ngram := range langNgrams {
+		if _, ok := textNgrams[ngram]; ok {
+			totalDist += i / 2
+		} else {
+			totalDist += maxDistance
+		}
+	}
+	return totalDist
+}
+
+// shortTextEvidence returns the factor by which the confidence of the detection of lang
+// in a short text is scaled down, from the number of trigrams and the number of words of
+// the dictionary of lang in the text.
+func shortTextEvidence(text string, trigramCount int, options Options, lang Lang, dict *dictionary) float64 {
+	matched := dict.match(textWords(text))[lang].matched
+	evidence := float64(trigramCount+shortTextWordEvidence*matched) / float64(options.shortTextTrigrams())
+	if evidence > 1 {
+		return 1
+	}
+	return evidence
+}
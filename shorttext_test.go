@@ -0,0 +1,83 @@
+package whatlanggo
+
+import (
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestDetectShortText(t *testing.T) {
+	tests := map[string]Lang{
+		"Mi ne scias":            Epo,
+		"Ik weet het niet":       Nld,
+		"Ich weiß es nicht":      Deu,
+		"Dov'è il bagno?":        Ita,
+		"where is the station":   Eng,
+		"Я не знаю, що робити":   Ukr,
+		"dónde está la estación": Spa,
+	}
+
+	for text, want := range tests {
+		if !(Options{}).isShortText(len(getTrigramsWithPositions(text))) {
+			t.Fatalf("%q is not a short text", text)
+		}
+		if got := DetectLang(text); got != want {
+			t.Fatalf("%q: want %v got %v", text, LangToString(want), LangToString(got))
+		}
+	}
+}
+
+func TestDetectShortTextConfidence(t *testing.T) {
+	text := "Mi ne scias"
+
+	//Three words are too little evidence for this text to be reliable, whatever its margin.
+	info := Detect(text)
+	if info.Lang != Epo || info.Confidence <= 0 || info.Confidence >= 0.5 || info.IsReliable() {
+		t.Fatalf("want an unreliable %v got %v", LangToString(Epo), info)
+	}
+
+	//The confidence is not scaled down when the short-text model is disabled.
+	disabled := DetectWithOptions(text, Options{ShortTextTrigrams: -1})
+	if disabled.Lang != Epo || disabled.Confidence <= info.Confidence {
+		t.Fatalf("want a more confident %v got %v", LangToString(Epo), disabled)
+	}
+
+	//The same text in a longer one is detected with more confidence.
+	long := Detect(strings.Repeat("Mi ne scias, ĉu vi scias? ", 3))
+	if long.Lang != Epo || long.Confidence <= info.Confidence {
+		t.Fatalf("want a more confident %v got %v", LangToString(Epo), long)
+	}
+
+	//Raising the threshold lowers the confidence.
+	higher := DetectWithOptions(text, Options{ShortTextTrigrams: 2 * DefaultShortTextTrigrams})
+	if higher.Lang != Epo || higher.Confidence >= info.Confidence {
+		t.Fatalf("want a less confident %v got %v", LangToString(Epo), higher)
+	}
+}
+
+func TestScriptWords(t *testing.T) {
+	for script, wordList := range scriptWords {
+		for lang, words := range wordList {
+			if _, ok := scriptProfiles[script][lang]; !ok {
+				t.Fatalf("%v has words but no profile in %s", LangToString(lang), ScriptCode(script))
+			}
+
+			seen := map[string]bool{}
+			for _, word := range words {
+				if seen[word] {
+					t.Fatalf("%v: duplicate word %q", LangToString(lang), word)
+				}
+				seen[word] = true
+
+				if words := textWords(word); len(words) != 1 || words[0] != word {
+					t.Fatalf("%v: %q is not a lowercase word", LangToString(lang), word)
+				}
+				for _, r := range word {
+					if unicode.IsLetter(r) && !unicode.Is(script, r) {
+						t.Fatalf("%v: %q is not written in %s", LangToString(lang), word, ScriptCode(script))
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package whatlanggo
+
+import (
+	"sort"
+	"unicode"
+)
+
+// SupportedLanguages returns all the languages whatlanggo can detect, sorted by their
+// ISO 639-3 code.
+func SupportedLanguages() []Lang {
+	langs := make([]Lang, 0, len(Langs))
+	for lang := range Langs {
+		langs = append(langs, lang)
+	}
+	sortLangs(langs)
+	return langs
+}
+
+// LanguagesForScript returns the languages whatlanggo can detect in a text written in
+// script, sorted by their ISO 639-3 code. script is one of the scripts returned by
+// DetectScript, other scripts have no languages.
+func LanguagesForScript(script *unicode.RangeTable) []Lang {
+	if lang, ok := scriptLangs[script]; ok {
+		return []Lang{lang}
+	}
+
+	profiles := scriptProfiles[script]
+	if len(profiles) == 0 {
+		return nil
+	}
+	langs := make([]Lang, 0, len(profiles))
+	for lang := range profiles {
+		langs = append(langs, lang)
+	}
+	sortLangs(langs)
+	return langs
+}
+
+// ScriptsForLanguage returns the scripts in which whatlanggo can detect lang, sorted
+// by their ISO 15924 code. They can differ from the scripts the language is usually
+// written in, which are listed by Metadata.
+func ScriptsForLanguage(lang Lang) []*unicode.RangeTable {
+	var scripts []*unicode.RangeTable
+	for script, l := range scriptLangs {
+		if l == lang {
+			scripts = append(scripts, script)
+		}
+	}
+	for script, profiles := range scriptProfiles {
+		if _, ok := profiles[lang]; ok {
+			scripts = append(scripts, script)
+		}
+	}
+	sort.Slice(scripts, func(i, j int) bool { return ScriptCode(scripts[i]) < ScriptCode(scripts[j]) })
+	return scripts
+}
+
+// sortLangs sorts langs by their ISO 639-3 code, which is also their numeric order.
+func sortLangs(langs []Lang) {
+	sort.Slice(langs, func(i, j int) bool { return langs[i] < langs[j] })
+}
@@ -0,0 +1,87 @@
+package whatlanggo
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestSupportedLanguages(t *testing.T) {
+	langs := SupportedLanguages()
+	if len(langs) != len(Langs) {
+		t.Fatalf("want %d languages got %d", len(Langs), len(langs))
+	}
+	for i := 1; i < len(langs); i++ {
+		if langs[i-1].Iso6393() >= langs[i].Iso6393() {
+			t.Fatalf("%s before %s", langs[i-1].Iso6393(), langs[i].Iso6393())
+		}
+	}
+}
+
+func TestLanguagesForScript(t *testing.T) {
+	tests := []struct {
+		script *unicode.RangeTable
+		want   []Lang
+	}{
+		{unicode.Hebrew, []Lang{Heb, Ydd}},
+		{unicode.Ethiopic, []Lang{Amh, Tir}},
+		{unicode.Han, []Lang{Cmn}},
+		{_HiraganaKatakana, []Lang{Jpn}},
+		{unicode.Hiragana, nil},
+		{unicode.Balinese, nil},
+		{nil, nil},
+	}
+
+	for _, tt := range tests {
+		got := LanguagesForScript(tt.script)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: want %v got %v", Scripts[tt.script], tt.want, got)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Fatalf("%s: want %v got %v", Scripts[tt.script], tt.want, got)
+			}
+		}
+	}
+
+	//Every language listed for a script lists that script.
+	for script := range scriptCodes {
+		for _, lang := range LanguagesForScript(script) {
+			found := false
+			for _, s := range ScriptsForLanguage(lang) {
+				found = found || s == script
+			}
+			if !found {
+				t.Fatalf("%s is not listed for %s", ScriptCode(script), LangToString(lang))
+			}
+		}
+	}
+}
+
+func TestScriptsForLanguage(t *testing.T) {
+	tests := map[Lang][]*unicode.RangeTable{
+		Eng: {unicode.Latin},
+		Azj: {unicode.Cyrillic, unicode.Latin},
+		Uig: {unicode.Arabic, unicode.Latin},
+		Jpn: {_HiraganaKatakana},
+		Kor: {unicode.Hangul},
+		Und: nil,
+	}
+
+	for lang, want := range tests {
+		got := ScriptsForLanguage(lang)
+		if len(got) != len(want) {
+			t.Fatalf("%s: want %d scripts got %d", LangToString(lang), len(want), len(got))
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("%s: want %s got %s", LangToString(lang), ScriptCode(want[i]), ScriptCode(got[i]))
+			}
+		}
+	}
+
+	for _, lang := range SupportedLanguages() {
+		if len(ScriptsForLanguage(lang)) == 0 {
+			t.Fatalf("%s: no script", LangToString(lang))
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package whatlanggo
+
+import "unicode"
+
+// tagSubtags maps languages to their primary BCP 47 language subtag, when it is not
+// their ISO 639-1 code.
+var tagSubtags = map[Lang]string{
+	Bho: "bho", // "bh" is a deprecated collection code.
+	Pes: "fa",
+	Ydd: "yi",
+}
+
+// defaultScript returns the script lang is usually written in, which is left out of
+// its BCP 47 tag.
+func defaultScript(lang Lang) *unicode.RangeTable {
+	if scripts := langMetadata[lang].Scripts; len(scripts) != 0 {
+		return scripts[0]
+	}
+	return nil
+}
+
+// langSubtag returns the primary BCP 47 language subtag of lang.
+func langSubtag(lang Lang) string {
+	if subtag, ok := tagSubtags[lang]; ok {
+		return subtag
+	}
+	if code := lang.Iso6391(); code != "" {
+		return code
+	}
+	return lang.Iso6393()
+}
+
+// Tag returns the BCP 47 language tag of the detection result, such as "ja", "sr-Latn",
+// "az-Cyrl", "zh-Hant" or "und-Thai". The script subtag is added only when the script
+// is not the one the language is usually written in. Chinese gets "Hans" or "Hant"
+// when the text tells Simplified and Traditional characters apart.
+func (info Info) Tag() string {
+	if !info.Lang.IsValid() {
+		if code := ScriptCode(info.Script); code != "" {
+			return "und-" + code
+		}
+		return "und"
+	}
+
+	tag := langSubtag(info.Lang)
+	switch {
+	case info.Lang == Cmn && info.Script == unicode.Han:
+		if info.HanVariant != "" {
+			tag += "-" + info.HanVariant
+		}
+	case info.Lang == Jpn && info.Script == unicode.Han:
+		// Japanese is written with both Kanji and Kana.
+	case info.Script != nil && info.Script != defaultScript(info.Lang):
+		if code := ScriptCode(info.Script); code != "" {
+			tag += "-" + code
+		}
+	}
+	return tag
+}
@@ -0,0 +1,79 @@
+package whatlanggo
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestInfoTag(t *testing.T) {
+	tests := []struct {
+		info Info
+		want string
+	}{
+		{Info{Lang: Eng, Script: unicode.Latin}, "en"},
+		{Info{Lang: Jpn, Script: _HiraganaKatakana}, "ja"},
+		{Info{Lang: Jpn, Script: unicode.Han}, "ja"},
+		{Info{Lang: Srp, Script: unicode.Cyrillic}, "sr"},
+		{Info{Lang: Srp, Script: unicode.Latin}, "sr-Latn"},
+		{Info{Lang: Azj, Script: unicode.Cyrillic}, "az-Cyrl"},
+		{Info{Lang: Tuk, Script: unicode.Cyrillic}, "tk-Cyrl"},
+		{Info{Lang: Uzb, Script: unicode.Latin}, "uz"},
+		{Info{Lang: Cmn, Script: unicode.Han}, "zh"},
+		{Info{Lang: Cmn, Script: unicode.Han, HanVariant: HanVariantTraditional}, "zh-Hant"},
+		{Info{Lang: Pes, Script: unicode.Arabic}, "fa"},
+		{Info{Lang: Ydd, Script: unicode.Hebrew}, "yi"},
+		{Info{Lang: Ceb, Script: unicode.Latin}, "ceb"},
+		{Info{Lang: Ilo}, "ilo"},
+		{Info{Lang: Skr, Script: unicode.Arabic}, "skr"},
+		{Info{Lang: Bho, Script: unicode.Devanagari}, "bho"},
+		{Info{Lang: Und, Script: unicode.Thai}, "und-Thai"},
+		{Info{Lang: Und}, "und"},
+	}
+
+	for _, tt := range tests {
+		got := tt.info.Tag()
+		if got != tt.want {
+			t.Fatalf("%s %s: want %s got %s", LangToString(tt.info.Lang), Scripts[tt.info.Script], tt.want, got)
+		}
+	}
+}
+
+func TestDetectTag(t *testing.T) {
+	tests := map[string]string{
+		"我爱你": "zh-Hans",
+		"其疾如風、其徐如林、侵掠如火、不動如山、難知如陰、動如雷震。": "zh-Hant",
+		"我们的国家很大":  "zh-Hans",
+		"どうもありがとう": "ja",
+		"Та нічого, все нормально. А в тебе як?": "uk",
+		"มนุษย์ทุกคนเกิดมามีอิสระ":               "th",
+	}
+
+	for text, want := range tests {
+		got := Detect(text).Tag()
+		if got != want {
+			t.Fatalf("%s: want %s got %s", text, want, got)
+		}
+	}
+
+	got := DetectWithOptions("มนุษย์ทุกคนเกิดมามีอิสระ", Options{Blacklist: map[Lang]bool{Tha: true}}).Tag()
+	if got != "und-Thai" {
+		t.Fatalf("want und-Thai got %s", got)
+	}
+}
+
+func TestDetectHanVariant(t *testing.T) {
+	tests := map[string]string{
+		"我爱你":         HanVariantSimplified,
+		"我愛你":         HanVariantTraditional,
+		"天地玄黄":        "",
+		"这個":          "",
+		"Hello world": "",
+	}
+
+	for text, want := range tests {
+		got := detectHanVariant(text)
+		if got != want {
+			t.Fatalf("%s: want %q got %q", text, want, got)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package whatlanggo
 import (
 	"sort"
 	"strings"
+	"sync"
 	"unicode"
 )
 
@@ -20,7 +21,23 @@ func toTrigramChar(ch rune) rune {
 }
 
 func getTrigramsWithPositions(text string) map[string]int {
-	counterMap := count(text)
+	return getNgramsWithPositions(text, defaultNgramOrder)
+}
+
+// getNgramsWithPositions maps the n-grams of text to their rank, from 0 for the most
+// frequent one.
+func getNgramsWithPositions(text string, n int) map[string]int {
+	ngrams := rankNgrams(countNgrams(text, n))
+	ngramsWithPositions := make(map[string]int, len(ngrams))
+	for i, ngram := range ngrams {
+		ngramsWithPositions[ngram] = i
+	}
+	return ngramsWithPositions
+}
+
+// rankNgrams sorts the n-grams of counterMap from the most to the least frequent one.
+// N-grams with the same count are sorted in reverse lexicographic order.
+func rankNgrams(counterMap map[string]int) []string {
 	trigrams := make([]trigram, len(counterMap))
 
 	i := 0
@@ -36,44 +53,169 @@ func getTrigramsWithPositions(text string) map[string]int {
 		return trigrams[i].count < trigrams[j].count
 	})
 
-	trigramsWithPositions := map[string]int{}
-
-	j := 0
-	for i := len(trigrams) - 1; i >= 0; i-- {
-		trigramsWithPositions[trigrams[i].trigram] = j
-		j++
+	ngrams := make([]string, len(trigrams))
+	for i := range trigrams {
+		ngrams[i] = trigrams[len(trigrams)-1-i].trigram
 	}
-	return trigramsWithPositions
+	return ngrams
 }
 
-func count(text string) map[string]int {
-	var r1, r2, r3 rune
-	trigrams := map[string]int{}
-	var txt []rune
-
+// countNgrams counts the n-grams of text, lowercase, with punctuation and digits
+// replaced by spaces and surrounded by spaces. N-grams with two spaces in a row are
+// left out.
+func countNgrams(text string, n int) map[string]int {
+	txt := []rune{' '}
 	for _, r := range text {
 		txt = append(txt, unicode.ToLower(toTrigramChar(r)))
 	}
 	txt = append(txt, ' ')
 
-	r1 = ' '
-	r2 = txt[0]
-	for i := 1; i < len(txt); i++ {
-		r3 = txt[i]
-		if !(r2 == ' ' && (r1 == ' ' || r3 == ' ')) {
-			trigram := []rune{}
-			trigram = append(trigram, r1)
-			trigram = append(trigram, r2)
-			trigram = append(trigram, r3)
-			if trigrams[string(trigram)] == 0 {
-				trigrams[string(trigram)] = 1
-			} else {
-				trigrams[string(trigram)]++
+	ngrams := map[string]int{}
+	for i := 0; i+n <= len(txt); i++ {
+		ngram := txt[i : i+n]
+		if !hasDoubleSpace(ngram) {
+			ngrams[string(ngram)]++
+		}
+	}
+	return ngrams
+}
+
+// hasDoubleSpace reports whether ngram holds two spaces in a row.
+func hasDoubleSpace(ngram []rune) bool {
+	for i := 1; i < len(ngram); i++ {
+		if ngram[i] == ' ' && ngram[i-1] == ' ' {
+			return true
+		}
+	}
+	return false
+}
+
+// packTrigram packs the runes of a trigram into an integer, 21 bits per rune, so that
+// packed trigrams compare like their strings.
+func packTrigram(r1, r2, r3 rune) uint64 {
+	return uint64(r1)<<42 | uint64(r2)<<21 | uint64(r3)
+}
+
+// packTrigramString packs a trigram given as a string.
+func packTrigramString(trigram string) uint64 {
+	var runes [3]rune
+	i := 0
+	for _, r := range trigram {
+		if i < len(runes) {
+			runes[i] = r
+		}
+		i++
+	}
+	return packTrigram(runes[0], runes[1], runes[2])
+}
+
+// packTrigrams packs the keys of a map of trigrams.
+func packTrigrams(trigrams map[string]int) map[uint64]int {
+	keys := make(map[uint64]int, len(trigrams))
+	for trigram, rank := range trigrams {
+		keys[packTrigramString(trigram)] = rank
+	}
+	return keys
+}
+
+// langKeyProfileList holds the packed trigrams of the profiles of languages.
+type langKeyProfileList map[Lang][]uint64
+
+// scriptProfileKeys holds the packed trigrams of scriptProfiles.
+var scriptProfileKeys = func() map[*unicode.RangeTable]langKeyProfileList {
+	scriptKeys := make(map[*unicode.RangeTable]langKeyProfileList, len(scriptProfiles))
+	for script, profiles := range scriptProfiles {
+		keys := make(langKeyProfileList, len(profiles))
+		for lang, trigrams := range profiles {
+			keys[lang] = make([]uint64, len(trigrams))
+			for i, trigram := range trigrams {
+				keys[lang][i] = packTrigramString(trigram)
 			}
 		}
-		r1 = r2
-		r2 = r3
+		scriptKeys[script] = keys
 	}
+	return scriptKeys
+}()
+
+type trigramCount struct {
+	key   uint64
+	count int
+}
+
+// trigramBuffer holds the buffers the trigrams of a text are ranked in. Buffers are
+// taken from trigramBuffers and put back once their ranks are no longer used, so that
+// ranking the trigrams of a text does not allocate once the buffers have grown.
+type trigramBuffer struct {
+	counts map[uint64]int
+	sorted []trigramCount
+	ranks  map[uint64]int
+}
+
+var trigramBuffers = sync.Pool{
+	New: func() interface{} {
+		return &trigramBuffer{counts: map[uint64]int{}, ranks: map[uint64]int{}}
+	},
+}
+
+func getTrigramBuffer() *trigramBuffer {
+	return trigramBuffers.Get().(*trigramBuffer)
+}
+
+func putTrigramBuffer(buf *trigramBuffer) {
+	trigramBuffers.Put(buf)
+}
+
+// rankTrigrams maps the packed trigrams of text to their rank, from 0 for the most
+// frequent one, like getTrigramsWithPositions. The map belongs to the buffer, and is
+// only valid until the buffer is used again.
+func (buf *trigramBuffer) rankTrigrams(text string) map[uint64]int {
+	for key := range buf.counts {
+		delete(buf.counts, key)
+	}
+	for key := range buf.ranks {
+		delete(buf.ranks, key)
+	}
+
+	// The text is surrounded by spaces, and trigrams with two spaces in a row are left out.
+	var r1, r2 rune
+	seen := 0
+	add := func(r3 rune) {
+		if seen >= 2 && !(r2 == ' ' && (r1 == ' ' || r3 == ' ')) {
+			buf.counts[packTrigram(r1, r2, r3)]++
+		}
+		r1, r2 = r2, r3
+		seen++
+	}
+	add(' ')
+	for _, r := range text {
+		add(unicode.ToLower(toTrigramChar(r)))
+	}
+	add(' ')
+
+	buf.sorted = buf.sorted[:0]
+	for key, count := range buf.counts {
+		buf.sorted = append(buf.sorted, trigramCount{key, count})
+	}
+	sort.Sort(buf)
+	for i, trigram := range buf.sorted {
+		buf.ranks[trigram.key] = i
+	}
+	return buf.ranks
+}
+
+// Len, Less and Swap sort the trigrams of the buffer from the most to the least
+// frequent one, and trigrams with the same count in reverse lexicographic order.
+func (buf *trigramBuffer) Len() int {
+	return len(buf.sorted)
+}
+
+func (buf *trigramBuffer) Less(i, j int) bool {
+	if buf.sorted[i].count == buf.sorted[j].count {
+		return buf.sorted[i].key > buf.sorted[j].key
+	}
+	return buf.sorted[i].count > buf.sorted[j].count
+}
 
-	return trigrams
+func (buf *trigramBuffer) Swap(i, j int) {
+	buf.sorted[i], buf.sorted[j] = buf.sorted[j], buf.sorted[i]
 }
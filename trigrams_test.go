@@ -1,28 +1,51 @@
 package whatlanggo
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestCount(t *testing.T) {
-	tests := map[string]map[string]int{
-		"":             {"": 0},
-		",":            {"": 0},
-		"a":            {" a ": 1},
-		"-a-":          {" a ": 1},
-		"yes":          {" ye": 1, "yes": 1, "es ": 1},
-		"Give - IT...": {" gi": 1, "giv": 1, "ive": 1, "ve ": 1, " it": 1, "it ": 1},
+	tests := map[string][]string{
+		"":             nil,
+		",":            nil,
+		"a":            {" a "},
+		"-a-":          {" a "},
+		"yes":          {" ye", "yes", "es "},
+		"Give - IT...": {" gi", "giv", "ive", "ve ", " it", "it "},
 	}
 
-	for key, value := range tests {
-		got := count(key)
-
-		for key1, value1 := range value {
-			if got[key1] != value1 {
-				t.Fatalf("%s got %d want %d", key1, got[key1], value1)
+	buf := getTrigramBuffer()
+	defer putTrigramBuffer(buf)
+	for text, trigrams := range tests {
+		got := buf.rankTrigrams(text)
+		if len(got) != len(trigrams) {
+			t.Fatalf("%q: want %d trigrams got %d", text, len(trigrams), len(got))
+		}
+		for _, trigram := range trigrams {
+			if _, ok := got[packTrigramString(trigram)]; !ok {
+				t.Fatalf("%q: want %q", text, trigram)
 			}
 		}
 	}
 }
 
+func TestCountNgrams(t *testing.T) {
+	tests := map[int]map[string]int{
+		2: {" g": 1, "gi": 1, "iv": 1, "ve": 1, "e ": 1, " i": 1, "it": 1, "t ": 1},
+		3: {" gi": 1, "giv": 1, "ive": 1, "ve ": 1, "e i": 1, " it": 1, "it ": 1},
+		4: {" giv": 1, "give": 1, "ive ": 1, "ve i": 1, "e it": 1, " it ": 1},
+		5: {" give": 1, "give ": 1, "ive i": 1, "ve it": 1, "e it ": 1},
+	}
+
+	for n, want := range tests {
+		got := countNgrams("Give IT...", n)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%d: want %v got %v", n, want, got)
+		}
+	}
+}
+
 func TestToTrigramChar(t *testing.T) {
 	tests := map[rune]rune{
 		'a': 'a', 'z': 'z', 'A': 'A', 'Z': 'Z', 'Ж': 'Ж', 'ß': 'ß',
@@ -52,3 +75,48 @@ func TestGetTrigramsWithPositions(t *testing.T) {
 		}
 	}
 }
+
+const benchmarkText = "Ĉu vi ne volas eklerni Esperanton? Bonvolu! Estas unu de la plej bonaj aferoj en la mondo, kaj oni lernas ĝin rapide."
+
+func BenchmarkGetTrigramsWithPositions(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		getTrigramsWithPositions(benchmarkText)
+	}
+}
+
+func BenchmarkRankTrigrams(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := getTrigramBuffer()
+		buf.rankTrigrams(benchmarkText)
+		putTrigramBuffer(buf)
+	}
+}
+
+func TestRankTrigrams(t *testing.T) {
+	examples := readExamples(t)
+
+	buf := getTrigramBuffer()
+	defer putTrigramBuffer(buf)
+	for _, text := range append([]string{"", ",", "xaaaaabbbbd", "Give - IT..."}, valuesOf(examples)...) {
+		want := getTrigramsWithPositions(text)
+		got := buf.rankTrigrams(text)
+		if len(got) != len(want) {
+			t.Fatalf("%q: want %d trigrams got %d", text, len(want), len(got))
+		}
+		for trigram, rank := range want {
+			if got, ok := got[packTrigramString(trigram)]; !ok || got != rank {
+				t.Fatalf("%q: %q: want rank %d got %d", text, trigram, rank, got)
+			}
+		}
+	}
+}
+
+func valuesOf(m map[string]string) []string {
+	values := make([]string, 0, len(m))
+	for _, value := range m {
+		values = append(values, value)
+	}
+	return values
+}
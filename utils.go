@@ -10,6 +10,16 @@ func isStopChar(r rune) bool {
 	return false
 }
 
+//containsLetter returns true if text contains at least one letter.
+func containsLetter(text string) bool {
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			return true
+		}
+	}
+	return false
+}
+
 //abs returns the absolute value of x.
 func abs(n int) int {
 	if n < 0 {
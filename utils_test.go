@@ -38,3 +38,20 @@ func TestAbs(t *testing.T) {
 		}
 	}
 }
+
+func TestContainsLetter(t *testing.T) {
+	tests := map[string]bool{
+		"":        false,
+		"12 - 3!": false,
+		"a":       true,
+		"1 ж":     true,
+		"ᬅᬓ":      true,
+	}
+
+	for text, want := range tests {
+		got := containsLetter(text)
+		if got != want {
+			t.Fatalf("%q want %t got %t", text, want, got)
+		}
+	}
+}
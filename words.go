@@ -0,0 +1,209 @@
+package whatlanggo
+
+import "unicode"
+
+// langWordList maps languages to their most frequent words, from the most to the least
+// frequent one. Words are lowercase and contain neither spaces nor punctuation, so that
+// words containing an apostrophe or a hyphen are left out.
+type langWordList map[Lang][]string
+
+// scriptWords maps scripts shared by several languages to the word lists of these languages.
+var scriptWords = map[*unicode.RangeTable]langWordList{
+	unicode.Latin:      latinWords,
+	unicode.Cyrillic:   cyrillicWords,
+	unicode.Devanagari: devanagariWords,
+	unicode.Hebrew:     hebrewWords,
+	unicode.Ethiopic:   ethiopicWords,
+	unicode.Arabic:     arabicWords,
+}
+
+var latinWords = langWordList{
+	Afr: {"die", "en", "van", "in", "is", "het", "dat", "te", "nie", "vir", "op", "met", "om", "wat", "sy", "was", "hy", "ek", "aan", "as", "word", "ook", "deur", "ons", "jy", "maar", "kan", "sal"},
+	Aka: {"na", "ne", "wɔ", "sɛ", "a", "no", "yɛ", "me", "de", "mu", "so", "ho", "ara", "nti", "bi", "bɛ", "kɔ", "wo", "nyinaa", "anaa", "ɔno", "yɛn", "mo", "wɔn", "nso", "ɛna"},
+	Azj: {"və", "bir", "bu", "da", "ki", "ilə", "üçün", "də", "o", "ən", "çox", "mən", "sən", "olan", "edir", "isə", "kimi", "daha", "var", "idi", "deyil", "hər", "biz", "siz", "onlar", "amma", "yox"},
+	Ceb: {"ang", "sa", "nga", "og", "ug", "mga", "si", "ni", "kay", "usa", "ka", "kini", "nag", "mao", "naa", "dili", "siya", "para", "ako", "ikaw", "kon", "apan", "unsa", "kami", "sila", "adunay"},
+	Ces: {"a", "se", "v", "na", "je", "že", "to", "s", "z", "do", "i", "o", "jsem", "by", "ale", "jak", "jako", "pro", "od", "k", "po", "tak", "já", "už", "jsou", "také", "které", "není"},
+	Dan: {"og", "i", "at", "det", "en", "den", "til", "er", "som", "på", "de", "med", "han", "af", "for", "ikke", "der", "var", "mig", "sig", "men", "et", "har", "om", "vi", "jeg", "hun", "kan"},
+	Deu: {"der", "die", "und", "in", "den", "von", "zu", "das", "mit", "sich", "des", "auf", "für", "ist", "im", "dem", "nicht", "ein", "eine", "als", "auch", "es", "an", "werden", "aus", "er", "hat", "dass", "sie", "nach", "wird", "bei", "ich", "du", "wir"},
+	Eng: {"the", "of", "and", "to", "a", "in", "is", "you", "that", "it", "he", "was", "for", "on", "are", "as", "with", "his", "they", "i", "at", "be", "this", "have", "from", "or", "one", "had", "by", "but", "not", "what", "all", "were", "we", "when", "your", "can", "there", "she", "do", "if", "will", "my", "me"},
+	Epo: {"la", "de", "kaj", "en", "estas", "al", "mi", "ne", "vi", "li", "ŝi", "ni", "ili", "por", "kun", "da", "sed", "pri", "tio", "kiu", "ke", "se", "el", "ĉu", "ankaŭ", "tre", "kiel", "nur"},
+	Est: {"ja", "on", "et", "ei", "ta", "see", "oli", "ka", "mis", "kui", "aga", "ma", "sa", "me", "te", "nad", "kes", "või", "nii", "veel", "siis", "ning", "mida", "seda", "kõik", "olen"},
+	Fin: {"ja", "on", "ei", "se", "että", "hän", "oli", "mutta", "kun", "niin", "myös", "tai", "kuin", "ovat", "joka", "minä", "sinä", "me", "te", "he", "mitä", "vain", "jo", "nyt", "olen", "en", "ole"},
+	Fra: {"de", "la", "le", "et", "les", "des", "en", "un", "du", "une", "que", "est", "pour", "qui", "dans", "a", "par", "plus", "pas", "au", "sur", "ne", "se", "ce", "il", "sont", "je", "nous", "vous", "avec", "mais", "ou", "elle", "on", "tu", "moi"},
+	Hat: {"li", "nan", "ak", "yo", "pou", "se", "mwen", "a", "la", "ki", "pa", "ou", "nou", "sa", "gen", "te", "m", "ap", "sou", "tout", "lè", "men", "konsa", "fè", "kounye", "yon"},
+	Hau: {"da", "a", "ta", "na", "ya", "ba", "su", "ga", "wanda", "cikin", "ne", "kuma", "shi", "ce", "don", "sun", "za", "ko", "amma", "mai", "daga", "kan", "wannan", "suka", "yi", "an", "ni", "kai"},
+	Hrv: {"i", "je", "u", "se", "na", "da", "su", "za", "od", "a", "s", "ne", "o", "što", "to", "kao", "iz", "koji", "ali", "bi", "će", "sa", "li", "ili", "biti", "samo", "kako", "sam", "smo"},
+	Hun: {"a", "az", "és", "hogy", "nem", "is", "egy", "meg", "de", "ez", "van", "volt", "azt", "csak", "már", "még", "el", "ha", "mint", "vagy", "ki", "le", "fel", "kell", "én", "te", "mi"},
+	Ibo: {"na", "nke", "ya", "ka", "ọ", "bụ", "nà", "ha", "m", "gị", "anyị", "ma", "ndị", "dị", "nwere", "onye", "ihe", "kwa", "otu", "mgbe", "site", "maka", "niile", "ebe"},
+	Ilo: {"ti", "nga", "ken", "iti", "dagiti", "a", "ni", "ket", "ta", "ngem", "daytoy", "kas", "idi", "isu", "no", "saan", "amin", "wenno", "tapno", "kadagiti", "siak", "sika", "isuna"},
+	Ind: {"yang", "dan", "di", "itu", "dengan", "untuk", "tidak", "ini", "dari", "dalam", "akan", "pada", "juga", "saya", "ke", "karena", "tersebut", "bisa", "ada", "mereka", "lebih", "kata", "sudah", "atau", "saat", "oleh", "menjadi", "orang", "kami", "apa", "aku", "kamu"},
+	Ita: {"di", "e", "il", "la", "che", "è", "per", "un", "in", "del", "non", "a", "una", "i", "le", "si", "da", "con", "al", "sono", "dei", "come", "più", "ma", "ha", "gli", "lo", "anche", "alla", "questo", "ci", "mi", "se", "io", "ti"},
+	Jav: {"lan", "ing", "kang", "iku", "karo", "ora", "sing", "ana", "aku", "kanggo", "saka", "ya", "wis", "dadi", "uga", "bisa", "wong", "iki", "menyang", "marang", "nganti", "utawa", "nalika", "kabeh", "sampeyan", "kowe", "arep"},
+	Kin: {"na", "ya", "mu", "ku", "kandi", "ni", "no", "ko", "iyo", "ubwo", "cyangwa", "abantu", "kuri", "ntabwo", "uko", "ari", "bari", "cya", "bya", "rya", "uyu", "iki", "ariko", "cyane", "byose"},
+	Kur: {"û", "di", "de", "bi", "ji", "ku", "ev", "li", "na", "ew", "re", "jî", "an", "wî", "wê", "min", "me", "be", "bû", "ye", "da", "vê", "hene", "kir", "tê", "ez", "tu", "em"},
+	Lav: {"un", "ir", "ka", "ar", "par", "no", "uz", "ne", "bet", "kā", "tas", "viņš", "es", "tu", "mēs", "jūs", "vai", "bija", "arī", "pie", "kas", "tā", "viņa", "to", "ko", "man"},
+	Lit: {"ir", "yra", "kad", "į", "su", "tai", "iš", "o", "ne", "kaip", "bet", "jo", "per", "ar", "už", "buvo", "mes", "jie", "aš", "jūs", "tik", "dar", "apie", "tu", "jis", "ji", "nes"},
+	Mlg: {"ny", "sy", "ary", "ho", "dia", "tsy", "fa", "an", "izay", "no", "amin", "ireo", "ka", "izy", "aho", "hoe", "raha", "ity", "teo", "azy", "ianao", "isika", "koa", "misy", "tamin"},
+	Nld: {"de", "van", "een", "het", "en", "in", "is", "dat", "op", "te", "zijn", "met", "voor", "niet", "aan", "er", "die", "ook", "als", "maar", "om", "dan", "bij", "ik", "je", "door", "wordt", "nog", "wij", "jij", "heb"},
+	Nno: {"og", "i", "det", "er", "som", "ein", "på", "til", "av", "å", "for", "med", "at", "dei", "ikkje", "den", "har", "var", "eg", "om", "eit", "men", "seg", "så", "frå", "meg", "kva", "korleis"},
+	Nob: {"og", "i", "det", "er", "som", "en", "på", "til", "av", "å", "for", "med", "at", "de", "ikke", "den", "har", "var", "jeg", "om", "et", "men", "seg", "så", "fra", "meg", "hva", "hvordan"},
+	Nya: {"ndi", "kuti", "ku", "mu", "pa", "ya", "wa", "la", "ndipo", "koma", "ali", "anthu", "zomwe", "chifukwa", "kapena", "ine", "iye", "ife", "inu", "ngati", "onse", "kwa", "za", "komanso", "kwambiri"},
+	Orm: {"fi", "kan", "akka", "irra", "keessa", "ni", "hin", "kana", "sana", "waan", "yeroo", "isaa", "isa", "ture", "kun", "keessatti", "garuu", "akkasumas", "biyya", "hunda", "ana", "ati", "nuti", "isaan", "inni"},
+	Pol: {"w", "i", "na", "się", "z", "nie", "do", "to", "że", "jest", "o", "jak", "a", "po", "co", "tak", "za", "od", "ale", "ich", "jego", "już", "przez", "są", "dla", "tym", "było", "może", "czy", "ja", "ty", "mnie"},
+	Por: {"de", "a", "o", "que", "e", "do", "da", "em", "um", "para", "é", "com", "não", "uma", "os", "no", "se", "na", "por", "mais", "as", "dos", "como", "mas", "foi", "ao", "ele", "das", "tem", "à", "seu", "sua", "ou", "ser", "quando", "muito", "há", "nos", "já", "está", "eu", "também", "você", "isso"},
+	Ron: {"de", "și", "în", "a", "la", "cu", "care", "pe", "nu", "o", "un", "din", "să", "se", "că", "mai", "ca", "pentru", "este", "au", "fost", "sau", "al", "ce", "lui", "sunt", "iar", "dar", "eu", "tu", "noi"},
+	Run: {"na", "ya", "mu", "ku", "kandi", "ni", "no", "ko", "canke", "iyo", "abantu", "kuri", "ivyo", "uwo", "ubu", "ariko", "cane", "vyose", "ari", "bari", "uko", "ico", "nta", "ubwo"},
+	Slv: {"in", "je", "da", "se", "v", "na", "za", "so", "ne", "z", "pa", "s", "kot", "bi", "tudi", "ki", "to", "po", "od", "ali", "sem", "še", "o", "jaz", "ti", "smo", "kaj"},
+	Sna: {"uye", "ne", "kuti", "ku", "mu", "kana", "asi", "zvino", "iye", "ini", "isu", "imi", "vanhu", "nokuti", "zvakanaka", "zvose", "ndi", "ndiri", "iwe", "zvake", "kwete", "chete", "pamusoro"},
+	Som: {"iyo", "oo", "ku", "ka", "u", "in", "waa", "ah", "ay", "uu", "la", "ee", "aan", "ayaa", "soo", "wax", "isaga", "kale", "ama", "laakiin", "si", "leh", "waxaa", "ma", "iska"},
+	Spa: {"de", "la", "que", "el", "en", "y", "a", "los", "se", "del", "las", "un", "por", "con", "no", "una", "su", "para", "es", "al", "lo", "como", "más", "pero", "sus", "le", "ya", "o", "este", "sí", "porque", "esta", "entre", "cuando", "muy", "sin", "sobre", "también", "me", "hasta", "hay", "donde", "yo", "tú"},
+	Swe: {"och", "i", "att", "det", "som", "en", "på", "är", "av", "för", "med", "till", "den", "inte", "har", "de", "om", "ett", "var", "jag", "så", "men", "från", "vi", "kan", "du", "hon", "mig"},
+	Tgl: {"ang", "ng", "sa", "na", "mga", "at", "ay", "si", "ni", "ako", "ka", "siya", "hindi", "ko", "mo", "ito", "para", "kung", "may", "lang", "po", "pero", "kami", "sila", "ikaw", "natin"},
+	Tuk: {"we", "bu", "bir", "bilen", "üçin", "hem", "ol", "men", "sen", "biz", "siz", "olar", "bolup", "has", "iň", "köp", "diýip", "bar", "ýok", "soň", "ýaly", "edip", "ýa", "da", "ýene"},
+	Tur: {"ve", "bir", "bu", "da", "de", "için", "ile", "çok", "olarak", "daha", "ne", "en", "gibi", "o", "kadar", "sonra", "ama", "var", "ben", "her", "değil", "olan", "şey", "mi", "ki", "onun", "olduğu", "yok", "sen", "biz"},
+	Uig: {"we", "bu", "bir", "bilen", "üchün", "u", "men", "biz", "siz", "ular", "emes", "bar", "yoq", "dep", "boldi", "hem", "lekin", "her", "köp", "eng", "sen", "bolup", "qilip"},
+	Uzb: {"va", "bu", "bilan", "uchun", "bir", "ham", "u", "deb", "edi", "emas", "esa", "qilib", "kerak", "men", "biz", "siz", "ular", "lekin", "yoki", "har", "eng", "juda", "shu", "sen", "bor", "yoq"},
+	Vie: {"của", "và", "các", "có", "là", "trong", "được", "cho", "không", "người", "những", "một", "với", "đã", "này", "để", "khi", "đến", "từ", "nhiều", "về", "như", "năm", "cũng", "ra", "thì", "tôi", "đó", "sẽ", "bạn"},
+	Yor: {"ti", "ní", "ó", "sì", "àti", "wọn", "fún", "kò", "jẹ́", "mo", "láti", "pé", "náà", "ẹni", "nínú", "wà", "bá", "gbogbo", "ṣe", "yìí", "lọ", "bí", "a", "o", "èmi", "ìwọ"},
+	Zul: {"ukuthi", "futhi", "kodwa", "uma", "na", "kanye", "noma", "ngoba", "ngi", "wa", "kwa", "e", "lapho", "lokho", "kakhulu", "yena", "mina", "wena", "thina", "abantu", "konke", "manje", "khona", "lo"},
+}
+
+var cyrillicWords = langWordList{
+	Azj: {"вә", "бир", "бу", "да", "ки", "илә", "үчүн", "дә", "о", "ән", "чох", "мән", "сән", "олан", "едир", "исә", "кими", "даһа", "вар", "иди", "дейил", "һәр"},
+	Bel: {"і", "ў", "не", "на", "што", "я", "з", "у", "як", "гэта", "ён", "яна", "але", "па", "да", "мы", "вы", "так", "для", "ад", "ці", "ты", "яго", "быў"},
+	Bul: {"и", "на", "в", "да", "се", "не", "е", "за", "от", "че", "с", "по", "като", "това", "той", "тя", "са", "ще", "но", "аз", "ти", "ние", "си", "беше"},
+	Mkd: {"и", "на", "во", "да", "се", "не", "е", "за", "од", "што", "со", "ќе", "по", "како", "тоа", "тој", "таа", "но", "ги", "јас", "ти", "ние", "си", "беше"},
+	Rus: {"и", "в", "не", "на", "я", "что", "он", "с", "как", "а", "то", "это", "по", "но", "все", "она", "так", "его", "к", "из", "у", "за", "от", "мы", "вы", "же", "ты", "был", "для"},
+	Srp: {"и", "је", "у", "да", "се", "на", "за", "су", "не", "од", "са", "што", "то", "а", "као", "из", "који", "али", "би", "ће", "ја", "ти", "смо", "сам"},
+	Tuk: {"ве", "бу", "бир", "билен", "үчин", "хем", "ол", "мен", "сен", "биз", "сиз", "олар", "болуп", "иң", "көп", "бар", "ёк"},
+	Ukr: {"і", "в", "не", "на", "що", "я", "з", "у", "та", "він", "це", "як", "до", "а", "але", "її", "так", "ми", "ви", "вона", "від", "по", "за", "для", "ти", "був"},
+}
+
+var arabicWords = langWordList{
+	Arb: {"في", "من", "على", "أن", "إلى", "عن", "مع", "هذا", "التي", "الذي", "ما", "لا", "كان", "هذه", "أو", "بين", "كل", "قد", "ثم", "لم", "بعد", "هو", "هي", "أنا", "نحن"},
+	Pes: {"و", "در", "به", "از", "که", "این", "را", "با", "است", "برای", "آن", "یک", "می", "خود", "تا", "بر", "هم", "شد", "نیز", "ما", "من", "او", "بود", "شما"},
+	Skr: {"دے", "وچ", "تے", "کوں", "ہے", "اے", "دا", "دی", "نال", "ہن", "ہک", "او", "ایہ", "ہئی", "جو", "نہیں", "کیتا", "میں", "تساں", "اساں"},
+	Uig: {"ۋە", "بىر", "بۇ", "بىلەن", "ئۈچۈن", "ئۇ", "مەن", "بىز", "سىز", "ئۇلار", "ئەمەس", "بار", "يوق", "دەپ", "بولدى", "ھەم", "لېكىن", "ھەر", "كۆپ", "ئەڭ"},
+	Urd: {"کے", "میں", "کی", "ہے", "اور", "کا", "کو", "سے", "یہ", "نے", "کہ", "پر", "ہیں", "بھی", "تھا", "ایک", "وہ", "ہو", "گا", "تو", "آپ", "ہم"},
+}
+
+var devanagariWords = langWordList{
+	Bho: {"के", "बा", "में", "आ", "से", "ह", "कि", "ना", "बाटे", "रहे", "एगो", "हम", "तू", "ऊ", "रहल", "गइल", "कइल", "आपन"},
+	Hin: {"के", "है", "में", "की", "और", "से", "को", "का", "एक", "यह", "पर", "भी", "नहीं", "कि", "हैं", "था", "ने", "तो", "लिए", "जो", "कर", "मैं", "आप", "हम"},
+	Mai: {"आ", "के", "मे", "अछि", "छल", "सँ", "जे", "ई", "एक", "नहि", "से", "हम", "अहाँ", "ओ", "छथि", "लेल", "एहि", "कएल"},
+	Mar: {"आणि", "आहे", "या", "व", "की", "हे", "ते", "ला", "मध्ये", "त्या", "एक", "करून", "होते", "नाही", "म्हणून", "पण", "तर", "मी", "तुम्ही", "आम्ही"},
+	Nep: {"र", "छ", "को", "मा", "पनि", "ले", "छन्", "यो", "भएको", "गर्न", "एक", "हो", "भने", "तर", "थियो", "हुन", "म", "तपाईं", "हामी", "गरेको"},
+}
+
+var ethiopicWords = langWordList{
+	Amh: {"እና", "ነው", "ላይ", "ውስጥ", "ወደ", "ጋር", "ይህ", "ግን", "ሁሉ", "ምን", "እኔ", "አንተ", "እሱ", "እሷ", "ነበር", "የለም", "አለ", "ናቸው"},
+	Tir: {"እዩ", "ኣብ", "ናይ", "ምስ", "እሞ", "ከም", "ካብ", "ን", "እዚ", "ግን", "ኩሉ", "ኣነ", "ንስኻ", "ንሱ", "ኔሩ", "የለን", "እዮም"},
+}
+
+var hebrewWords = langWordList{
+	Heb: {"של", "את", "על", "לא", "הוא", "זה", "עם", "כי", "גם", "אני", "היא", "אם", "כל", "מה", "יש", "אבל", "או", "רק", "היה", "אין", "אתה", "אנחנו"},
+	Ydd: {"און", "דער", "די", "אין", "פון", "איז", "דאס", "ער", "מיט", "צו", "ניט", "זיך", "אויף", "נישט", "מען", "זי", "איך", "ווי", "מיר", "דו"},
+}
+
+// scriptMarkers maps scripts shared by several languages to the marker words of these
+// languages.
+var scriptMarkers = map[*unicode.RangeTable]langWordList{
+	unicode.Latin:      latinMarkers,
+	unicode.Cyrillic:   cyrillicMarkers,
+	unicode.Devanagari: devanagariMarkers,
+	unicode.Hebrew:     hebrewMarkers,
+	unicode.Ethiopic:   ethiopicMarkers,
+	unicode.Arabic:     arabicMarkers,
+}
+
+// Marker words are frequent words, mostly function words, that are used by a single
+// language of their script, such as "ikkje" in Norwegian Nynorsk, where Bokmål and
+// Danish have "ikke". They tell closely related languages apart.
+
+var latinMarkers = langWordList{
+	Afr: {"vir", "jy", "hulle", "baie", "wees"},
+	Aka: {"wɔ", "sɛ", "yɛ", "nyinaa", "anaa", "ɔno", "wɔn"},
+	Azj: {"və", "ilə", "üçün", "çox", "mən", "sən", "edir", "deyil", "isə"},
+	Ceb: {"ug", "kay", "kini", "dili", "naa", "mao", "unsa", "apan"},
+	Ces: {"že", "jsem", "jsou", "také", "které", "není", "jako", "však", "velmi", "protože"},
+	Dan: {"hvad", "noget", "meget", "efter", "gennem", "tilbage", "mellem", "nogle", "blev", "havde", "af"},
+	Deu: {"und", "nicht", "ist", "auch", "sich", "dass", "werden", "wird", "wir", "haben", "oder", "über", "sehr"},
+	Eng: {"the", "and", "you", "that", "with", "this", "have", "from", "which", "would", "were", "what", "there"},
+	Epo: {"estas", "ankaŭ", "ŝi", "ĉu", "kiu", "tio", "sed"},
+	Est: {"see", "mis", "kui", "aga", "või", "ning", "kõik", "siis", "väga"},
+	Fin: {"että", "hän", "mutta", "myös", "ovat", "minä", "sinä", "mitä", "vain", "kuin"},
+	Fra: {"les", "est", "pour", "qui", "dans", "pas", "sont", "nous", "vous", "avec", "elle", "être", "très", "cette"},
+	Hat: {"mwen", "nou", "pou", "ak", "gen", "konsa", "kounye", "yon"},
+	Hau: {"kuma", "wanda", "cikin", "wannan", "suka", "daga", "don"},
+	Hrv: {"što", "će", "koji", "kao", "biti", "kako", "sam", "također", "jer", "gdje", "tko", "vrlo", "bio"},
+	Hun: {"és", "hogy", "nem", "egy", "volt", "azt", "csak", "vagy", "már", "még"},
+	Ibo: {"nke", "bụ", "ndị", "nwere", "onye", "anyị", "gị", "ihe"},
+	Ilo: {"ken", "dagiti", "ket", "ngem", "daytoy", "saan", "tapno", "iti"},
+	Ind: {"yang", "tidak", "dengan", "untuk", "itu", "dari", "akan", "tersebut", "karena", "juga", "sudah"},
+	Ita: {"che", "è", "gli", "sono", "della", "anche", "questo", "più", "perché", "molto"},
+	Jav: {"lan", "ing", "kang", "iku", "karo", "ora", "sing", "menyang", "kanggo", "wis", "uga"},
+	Kin: {"cyangwa", "ntabwo", "cyane", "byose", "cya", "bya", "rya"},
+	Kur: {"û", "jî", "ev", "ew", "wî", "wê", "bû", "hene"},
+	Lav: {"kā", "viņš", "mēs", "arī", "bija", "tā", "viņa", "nav", "ļoti"},
+	Lit: {"yra", "kad", "aš", "buvo", "kaip", "labai", "nes", "jis"},
+	Mlg: {"ny", "ary", "tsy", "izay", "ireo", "izy", "ianao"},
+	Nld: {"een", "niet", "zijn", "voor", "wordt", "wij", "jij", "heb", "hebben", "naar"},
+	Nno: {"ikkje", "eg", "eit", "kva", "korleis", "noko", "mykje", "berre", "frå", "vart", "kvar"},
+	Nob: {"hva", "noe", "mye", "dere", "ble"},
+	Nya: {"ndipo", "koma", "anthu", "zomwe", "chifukwa", "kapena", "komanso", "kwambiri"},
+	Orm: {"fi", "akka", "irra", "keessa", "keessatti", "garuu", "akkasumas", "yeroo", "waan"},
+	Pol: {"się", "że", "jest", "są", "było", "może", "czy", "przez", "mnie", "już", "dla", "bardzo"},
+	Por: {"não", "você", "também", "muito", "isso", "são", "pelo", "pela", "ao", "às"},
+	Ron: {"și", "în", "că", "să", "pentru", "sunt", "care", "iar", "fost", "lui"},
+	Run: {"canke", "ivyo", "vyose", "cane", "ico", "nta"},
+	Slv: {"tudi", "kot", "sem", "še", "jaz", "zelo", "lahko", "kje", "kdo"},
+	Sna: {"uye", "asi", "zvino", "vanhu", "nokuti", "zvose", "kwete", "chete"},
+	Som: {"oo", "waa", "ayaa", "soo", "wax", "laakiin", "waxaa"},
+	Spa: {"los", "las", "muy", "porque", "también", "hay", "donde", "cuando"},
+	Swe: {"och", "är", "inte", "jag", "hon", "från", "också", "någon", "mycket", "vad", "hur"},
+	Tgl: {"ng", "hindi", "ito", "kung", "lang", "natin"},
+	Tuk: {"üçin", "diýip", "ýaly", "edip", "ýok", "iň"},
+	Tur: {"ve", "için", "ile", "çok", "olarak", "değil", "gibi", "kadar", "sonra", "şey"},
+	Uig: {"üchün", "emes", "boldi", "qilip"},
+	Uzb: {"va", "bilan", "uchun", "emas", "kerak", "juda", "yoki"},
+	Vie: {"của", "và", "các", "có", "là", "trong", "được", "không", "người", "những"},
+	Yor: {"àti", "wọn", "fún", "láti", "nínú", "gbogbo", "jẹ́", "ṣe"},
+	Zul: {"ukuthi", "futhi", "kodwa", "kanye", "noma", "ngoba", "lapho", "lokho", "kakhulu"},
+}
+
+var cyrillicMarkers = langWordList{
+	Azj: {"вә", "илә", "үчүн", "чох", "мән", "дејил"},
+	Bel: {"ў", "гэта", "ён", "яна", "быў", "ці", "яго"},
+	Bul: {"че", "това", "като", "ще", "аз", "този"},
+	Mkd: {"во", "ќе", "тоа", "со", "ги", "јас", "како"},
+	Rus: {"что", "это", "все", "его", "был", "она", "как"},
+	Srp: {"је", "су", "који", "ће", "као", "али", "сам", "смо", "јер"},
+	Tuk: {"билен", "үчин", "хем", "болуп"},
+	Ukr: {"що", "та", "він", "це", "її", "вона", "від", "був"},
+}
+
+var arabicMarkers = langWordList{
+	Arb: {"في", "على", "إلى", "عن", "التي", "الذي", "هذا", "هذه", "كان"},
+	Pes: {"در", "به", "از", "که", "این", "را", "است", "برای", "می"},
+	Skr: {"دے", "وچ", "تے", "کوں", "نال", "ہن", "ہک", "اساں"},
+	Uig: {"ۋە", "بىلەن", "ئۈچۈن", "ئۇلار", "ئەمەس"},
+	Urd: {"اور", "نے", "کے", "کا", "کو", "سے", "ہیں", "تھا"},
+}
+
+var devanagariMarkers = langWordList{
+	Bho: {"बा", "बाटे", "एगो", "रहल", "गइल", "कइल", "आपन"},
+	Hin: {"है", "हैं", "और", "नहीं", "था", "लिए", "यह"},
+	Mai: {"अछि", "छल", "सँ", "नहि", "अहाँ", "छथि", "एहि"},
+	Mar: {"आणि", "आहे", "मध्ये", "नाही", "होते", "म्हणून", "आम्ही"},
+	Nep: {"छ", "छन्", "पनि", "भएको", "गर्न", "थियो", "हामी", "तपाईं"},
+}
+
+var ethiopicMarkers = langWordList{
+	Amh: {"ነው", "እና", "ውስጥ", "ናቸው"},
+	Tir: {"እዩ", "ኣብ", "ናይ", "እዮም"},
+}
+
+var hebrewMarkers = langWordList{
+	Heb: {"של", "את", "הוא", "זה", "אני", "היא", "אבל"},
+	Ydd: {"און", "דער", "פון", "איז", "דאס", "ניט", "נישט", "איך"},
+}